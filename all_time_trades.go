@@ -0,0 +1,190 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxAllTimeTradesBatch is the maximum number of addresses per multi
+// all-time trades request.
+const MaxAllTimeTradesBatch = 20
+
+// allTimeFrame is the time_frame value selecting lifetime statistics.
+const allTimeFrame = "alltime"
+
+// AllTimeTradeStats contains a token's lifetime trading statistics.
+type AllTimeTradeStats struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// TotalTrade is the total number of trades.
+	TotalTrade int `json:"total_trade"`
+
+	// Buy is the number of buy trades.
+	Buy int `json:"buy"`
+
+	// Sell is the number of sell trades.
+	Sell int `json:"sell"`
+
+	// UniqueWallet is the number of unique wallets that traded the token.
+	// Zero if Birdeye does not report it.
+	UniqueWallet int `json:"unique_wallet"`
+
+	// TotalVolume is the total volume in token units.
+	TotalVolume decimal.Decimal `json:"total_volume"`
+
+	// TotalVolumeUSD is the total volume in USD.
+	TotalVolumeUSD decimal.Decimal `json:"total_volume_usd"`
+
+	// VolumeBuy is the buy volume in token units.
+	VolumeBuy decimal.Decimal `json:"volume_buy"`
+
+	// VolumeBuyUSD is the buy volume in USD.
+	VolumeBuyUSD decimal.Decimal `json:"volume_buy_usd"`
+
+	// VolumeSell is the sell volume in token units.
+	VolumeSell decimal.Decimal `json:"volume_sell"`
+
+	// VolumeSellUSD is the sell volume in USD.
+	VolumeSellUSD decimal.Decimal `json:"volume_sell_usd"`
+}
+
+// BuySellRatio returns lifetime buy volume divided by sell volume, in USD.
+//
+// A ratio above 1 means more buying than selling pressure. Returns false
+// if there is no sell volume, where the ratio is undefined.
+//
+// Example:
+//
+//	if ratio, ok := stats.BuySellRatio(); ok && ratio.LessThan(decimal.NewFromFloat(0.8)) {
+//	    log.Printf("%s has sustained sell pressure", stats.Address)
+//	}
+func (s AllTimeTradeStats) BuySellRatio() (decimal.Decimal, bool) {
+	if !s.VolumeSellUSD.IsPositive() {
+		return decimal.Zero, false
+	}
+	return s.VolumeBuyUSD.Div(s.VolumeSellUSD), true
+}
+
+// GetAllTimeTradeStats fetches lifetime trading statistics for a token.
+//
+// Example:
+//
+//	stats, err := client.GetAllTimeTradeStats(ctx, tokenAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("%d trades, $%s volume", stats.TotalTrade, stats.TotalVolumeUSD.StringFixed(0))
+func (c *Client) GetAllTimeTradeStats(ctx context.Context, address string) (*AllTimeTradeStats, error) {
+	const path = "/defi/v3/all-time/trades/single"
+
+	if address == "" {
+		return nil, &APIError{StatusCode: 400, Message: "address is required", Path: path}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+	params.Set("time_frame", allTimeFrame)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := parseResponse[[]AllTimeTradeStats](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with an empty list for unknown tokens.
+	if len(*items) == 0 {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "all-time trade stats not found",
+			Path:       path,
+		}
+	}
+	stats := (*items)[0]
+
+	c.logger.Debug("fetched all-time trade stats",
+		"address", address,
+		"total_trade", stats.TotalTrade,
+		"total_volume_usd", stats.TotalVolumeUSD.String(),
+	)
+
+	return &stats, nil
+}
+
+// GetMultipleAllTimeTradeStats fetches lifetime trading statistics for
+// multiple tokens.
+//
+// Birdeye supports up to 20 addresses per request. This method splits
+// larger lists into batches and fetches them concurrently.
+//
+// Returns a map of address -> stats. Unknown tokens are omitted.
+//
+// Example:
+//
+//	stats, err := client.GetMultipleAllTimeTradeStats(ctx, addresses)
+//	if err != nil {
+//	    return err
+//	}
+//	for addr, s := range stats {
+//	    log.Printf("%s: %d lifetime trades", addr, s.TotalTrade)
+//	}
+func (c *Client) GetMultipleAllTimeTradeStats(ctx context.Context, addresses []string) (map[string]AllTimeTradeStats, error) {
+	const path = "/defi/v3/all-time/trades/multiple"
+
+	if len(addresses) == 0 {
+		return make(map[string]AllTimeTradeStats), nil
+	}
+
+	for _, addr := range addresses {
+		if addr == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "address list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	result, err := fetchBatches(ctx, c, addresses, MaxAllTimeTradesBatch,
+		func(ctx context.Context, batch []string) (map[string]AllTimeTradeStats, error) {
+			payload := map[string]string{
+				"list_address": strings.Join(batch, ","),
+				"time_frame":   allTimeFrame,
+			}
+
+			body, err := c.doPost(ctx, path, nil, payload)
+			if err != nil {
+				return nil, err
+			}
+
+			items, err := parseResponse[[]AllTimeTradeStats](body)
+			if err != nil {
+				return nil, err
+			}
+
+			stats := make(map[string]AllTimeTradeStats, len(*items))
+			for _, s := range *items {
+				if s.Address != "" {
+					stats[s.Address] = s
+				}
+			}
+			return stats, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched multiple all-time trade stats",
+		"requested", len(addresses),
+		"received", len(result),
+	)
+
+	return result, nil
+}
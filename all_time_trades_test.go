@@ -0,0 +1,204 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetAllTimeTradeStats_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(wrapResponse([]map[string]interface{}{
+			{
+				"address":          "Token",
+				"total_trade":      1500,
+				"buy":              900,
+				"sell":             600,
+				"unique_wallet":    420,
+				"total_volume":     "123456789.123456789",
+				"total_volume_usd": 250000.5,
+				"volume_buy":       70000000,
+				"volume_buy_usd":   150000.25,
+				"volume_sell":      53456789.123456789,
+				"volume_sell_usd":  100000.25,
+			},
+		}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	stats, err := client.GetAllTimeTradeStats(context.Background(), "Token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["address"][0] != "Token" || query["time_frame"][0] != "alltime" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if stats.TotalTrade != 1500 || stats.Buy != 900 || stats.Sell != 600 || stats.UniqueWallet != 420 {
+		t.Errorf("unexpected counts: %+v", stats)
+	}
+	if !stats.TotalVolume.Equal(decimal.RequireFromString("123456789.123456789")) {
+		t.Errorf("expected exact total volume, got %s", stats.TotalVolume)
+	}
+	if !stats.VolumeBuyUSD.Equal(decimal.RequireFromString("150000.25")) {
+		t.Errorf("expected buy volume 150000.25, got %s", stats.VolumeBuyUSD)
+	}
+}
+
+func TestGetAllTimeTradeStats_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/all-time/trades/single": wrapResponse([]interface{}{}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetAllTimeTradeStats(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected 404 APIError, got %v", err)
+	}
+}
+
+func TestGetAllTimeTradeStats_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	_, err := client.GetAllTimeTradeStats(context.Background(), "")
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetAllTimeTradeStats_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/all-time/trades/single": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetAllTimeTradeStats(context.Background(), "Token"); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetMultipleAllTimeTradeStats_PostsBatches(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches []int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+
+		var payload struct {
+			ListAddress string `json:"list_address"`
+			TimeFrame   string `json:"time_frame"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		if payload.TimeFrame != "alltime" {
+			t.Errorf("expected time_frame alltime, got %q", payload.TimeFrame)
+		}
+
+		addrs := strings.Split(payload.ListAddress, ",")
+		mu.Lock()
+		batches = append(batches, len(addrs))
+		mu.Unlock()
+
+		items := []map[string]interface{}{}
+		for _, addr := range addrs {
+			if addr == "token7" {
+				continue // unknown token
+			}
+			items = append(items, map[string]interface{}{"address": addr, "total_trade": 10, "volume_buy_usd": 30, "volume_sell_usd": 20})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(items))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	addresses := make([]string, 45)
+	for i := range addresses {
+		addresses[i] = "token" + strconv.Itoa(i)
+	}
+
+	stats, err := client.GetMultipleAllTimeTradeStats(context.Background(), addresses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(stats) != 44 {
+		t.Errorf("expected 44 entries, got %d", len(stats))
+	}
+	if _, ok := stats["token7"]; ok {
+		t.Error("expected unknown token to be omitted")
+	}
+	if stats["token42"].TotalTrade != 10 {
+		t.Errorf("unexpected stats: %+v", stats["token42"])
+	}
+	if len(batches) != 3 {
+		t.Errorf("expected 3 batches, got %v", batches)
+	}
+	for _, n := range batches {
+		if n > MaxAllTimeTradesBatch {
+			t.Errorf("batch of %d exceeds limit", n)
+		}
+	}
+}
+
+func TestGetMultipleAllTimeTradeStats_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	if _, err := client.GetMultipleAllTimeTradeStats(context.Background(), []string{"a", ""}); err == nil {
+		t.Error("expected error for empty address")
+	}
+
+	stats, err := client.GetMultipleAllTimeTradeStats(context.Background(), nil)
+	if err != nil || len(stats) != 0 {
+		t.Errorf("expected empty result for empty list, got %v, %v", stats, err)
+	}
+}
+
+func TestAllTimeTradeStats_BuySellRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		buy      string
+		sell     string
+		expected string
+		ok       bool
+	}{
+		{"buy pressure", "150", "100", "1.5", true},
+		{"sell pressure", "50", "200", "0.25", true},
+		{"no sells", "100", "0", "0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := AllTimeTradeStats{
+				VolumeBuyUSD:  decimal.RequireFromString(tt.buy),
+				VolumeSellUSD: decimal.RequireFromString(tt.sell),
+			}
+			ratio, ok := stats.BuySellRatio()
+			if ok != tt.ok || !ratio.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("expected %s, %v; got %s, %v", tt.expected, tt.ok, ratio, ok)
+			}
+		})
+	}
+}
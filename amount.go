@@ -0,0 +1,12 @@
+package birdeye
+
+import "github.com/shopspring/decimal"
+
+// uiAmount adjusts a raw token amount for the token's decimals.
+//
+// Birdeye also reports decimal-adjusted amounts, but as floating-point
+// numbers that lose precision for large balances and tokens with many
+// decimals. Deriving them from the raw integer amount keeps them exact.
+func uiAmount(raw decimal.Decimal, decimals int) decimal.Decimal {
+	return raw.Shift(-int32(decimals))
+}
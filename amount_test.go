@@ -0,0 +1,27 @@
+package birdeye
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestUIAmount(t *testing.T) {
+	tests := []struct {
+		raw      string
+		decimals int
+		expected string
+	}{
+		{"1500000", 6, "1.5"},
+		{"123456789012345678901234567", 18, "123456789.012345678901234567"},
+		{"-2500", 3, "-2.5"},
+		{"42", 0, "42"},
+	}
+
+	for _, tt := range tests {
+		got := uiAmount(decimal.RequireFromString(tt.raw), tt.decimals)
+		if !got.Equal(decimal.RequireFromString(tt.expected)) {
+			t.Errorf("uiAmount(%s, %d) = %s, expected %s", tt.raw, tt.decimals, got, tt.expected)
+		}
+	}
+}
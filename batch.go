@@ -0,0 +1,153 @@
+package birdeye
+
+import (
+	"context"
+	"sync"
+)
+
+// batchFunc fetches results for a single batch of addresses.
+type batchFunc[V any] func(ctx context.Context, batch []string) (map[string]V, error)
+
+// fetchBatches splits addresses into batches of at most size, fetches them
+// concurrently and merges the results.
+//
+// Duplicate addresses are requested once. At most c.maxConcurrency batches
+// are in flight at a time. The first error cancels the remaining batches
+// and is returned.
+func fetchBatches[V any](ctx context.Context, c *Client, addresses []string, size int, fetch batchFunc[V]) (map[string]V, error) {
+	unique := make([]string, 0, len(addresses))
+	seen := make(map[string]struct{}, len(addresses))
+	for _, addr := range addresses {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		unique = append(unique, addr)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		result   = make(map[string]V, len(unique))
+		sem      = make(chan struct{}, max(c.maxConcurrency, 1))
+	)
+
+	for i := 0; i < len(unique); i += size {
+		batch := unique[i:min(i+size, len(unique))]
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			values, err := fetch(ctx, batch)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			for addr, v := range values {
+				result[addr] = v
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// derefNonNil copies the non-nil entries of a multi-address response.
+// Birdeye returns null entries for addresses it has no data for.
+func derefNonNil[V any](m map[string]*V) map[string]V {
+	result := make(map[string]V, len(m))
+	for addr, v := range m {
+		if v != nil {
+			result[addr] = *v
+		}
+	}
+	return result
+}
+
+// eachFunc fetches the result for a single key.
+type eachFunc[V any] func(ctx context.Context, key string) (V, error)
+
+// fetchEach fetches a result per key concurrently, collecting results and
+// errors separately.
+//
+// Unlike fetchBatches, a failure for one key does not stop the others.
+// Duplicate keys are fetched once. At most c.maxConcurrency requests are
+// in flight at a time; keys not started before ctx is done record its
+// error.
+func fetchEach[V any](ctx context.Context, c *Client, keys []string, fetch eachFunc[V]) (map[string]V, map[string]error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]V, len(keys))
+		errs    = make(map[string]error)
+		seen    = make(map[string]struct{}, len(keys))
+		sem     = make(chan struct{}, max(c.maxConcurrency, 1))
+	)
+
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			errs[key] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			v, err := fetch(ctx, key)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs[key] = err
+				return
+			}
+			results[key] = v
+		}()
+	}
+
+	wg.Wait()
+
+	return results, errs
+}
@@ -0,0 +1,116 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchBatches_BoundedConcurrency(t *testing.T) {
+	client, _ := NewClient("test-key", WithMaxConcurrency(2))
+
+	var addresses []string
+	for i := 0; i < 10; i++ {
+		addresses = append(addresses, "addr"+strconv.Itoa(i))
+	}
+
+	var inFlight, peak atomic.Int32
+	result, err := fetchBatches(context.Background(), client, addresses, 1,
+		func(_ context.Context, batch []string) (map[string]int, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return map[string]int{batch[0]: len(batch)}, nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 10 {
+		t.Errorf("expected 10 results, got %d", len(result))
+	}
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent batches, got %d", peak.Load())
+	}
+}
+
+func TestFetchBatches_Error(t *testing.T) {
+	client, _ := NewClient("test-key", WithMaxConcurrency(1))
+	batchErr := errors.New("boom")
+
+	var calls atomic.Int32
+	_, err := fetchBatches(context.Background(), client, []string{"a", "b", "c"}, 1,
+		func(ctx context.Context, batch []string) (map[string]int, error) {
+			calls.Add(1)
+			return nil, batchErr
+		})
+
+	if !errors.Is(err, batchErr) {
+		t.Errorf("expected batch error, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected remaining batches to be skipped, got %d calls", calls.Load())
+	}
+}
+
+func TestFetchEach_PerKeyErrors(t *testing.T) {
+	client, _ := NewClient("test-key", WithMaxConcurrency(2))
+
+	var inFlight, peak, calls atomic.Int32
+	results, errs := fetchEach(context.Background(), client, []string{"a", "b", "bad", "c", "a"},
+		func(_ context.Context, key string) (string, error) {
+			calls.Add(1)
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			if key == "bad" {
+				return "", errors.New("boom")
+			}
+			return key + "!", nil
+		})
+
+	if len(results) != 3 || results["b"] != "b!" {
+		t.Errorf("unexpected results: %v", results)
+	}
+	if len(errs) != 1 || errs["bad"] == nil {
+		t.Errorf("expected one error for bad, got %v", errs)
+	}
+	if c := calls.Load(); c != 4 {
+		t.Errorf("expected 4 calls (duplicates fetched once), got %d", c)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("expected at most 2 in flight, got %d", p)
+	}
+}
+
+func TestFetchEach_Canceled(t *testing.T) {
+	client, _ := NewClient("test-key", WithMaxConcurrency(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, errs := fetchEach(ctx, client, []string{"a", "b"},
+		func(ctx context.Context, key string) (int, error) {
+			return 0, ctx.Err()
+		})
+
+	if len(results) != 0 || len(errs) != 2 {
+		t.Errorf("expected 2 errors, got results %v, errs %v", results, errs)
+	}
+}
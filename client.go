@@ -1,6 +1,7 @@
 package birdeye
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -30,6 +31,14 @@ const (
 	// DefaultRetryWaitMax is the maximum wait time between retries.
 	DefaultRetryWaitMax = 3 * time.Second
 
+	// DefaultMaxConcurrency is the maximum number of concurrent requests
+	// issued by batched methods.
+	DefaultMaxConcurrency = 4
+
+	// DefaultNetworkCacheTTL is how long supported network listings are
+	// cached.
+	DefaultNetworkCacheTTL = time.Hour
+
 	// chainSolana is the Solana chain identifier for Birdeye API.
 	chainSolana = "solana"
 )
@@ -60,21 +69,28 @@ func (noopLogger) Error(_ string, _ ...interface{}) {}
 
 // Client provides methods for interacting with the Birdeye API.
 type Client struct {
-	apiKey     string
-	baseURL    string
-	httpClient *http.Client
-	logger     Logger
+	apiKey          string
+	baseURL         string
+	httpClient      *http.Client
+	logger          Logger
+	now             func() time.Time
+	maxConcurrency  int
+	networkCacheTTL time.Duration
+	networks        networkCache
 }
 
 // config holds internal configuration built from options.
 type config struct {
-	baseURL      string
-	timeout      time.Duration
-	maxRetries   int
-	retryWaitMin time.Duration
-	retryWaitMax time.Duration
-	logger       Logger
-	httpClient   *http.Client
+	baseURL         string
+	timeout         time.Duration
+	maxRetries      int
+	retryWaitMin    time.Duration
+	retryWaitMax    time.Duration
+	logger          Logger
+	httpClient      *http.Client
+	now             func() time.Time
+	maxConcurrency  int
+	networkCacheTTL time.Duration
 }
 
 // Option configures the Client.
@@ -109,6 +125,22 @@ func WithRetryWait(min, max time.Duration) Option {
 	}
 }
 
+// WithMaxConcurrency sets the maximum number of concurrent requests issued
+// by methods that split large inputs into batches.
+func WithMaxConcurrency(n int) Option {
+	return func(c *config) {
+		c.maxConcurrency = n
+	}
+}
+
+// WithNetworkCacheTTL sets how long ListNetworks and SupportedNetworks
+// results are cached. Zero disables caching.
+func WithNetworkCacheTTL(d time.Duration) Option {
+	return func(c *config) {
+		c.networkCacheTTL = d
+	}
+}
+
 // WithLogger sets a custom logger for the client.
 // If not set, logging is disabled (noop logger is used).
 func WithLogger(l Logger) Option {
@@ -125,6 +157,15 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithClock sets the function the client uses to read the current time.
+// It is used by time-relative helpers such as TokenCreationInfo.Age and
+// exists mainly so tests can supply a fixed clock. Defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
+
 // NewClient creates a new Birdeye API client.
 //
 // The apiKey is required. Additional options can be provided to customize
@@ -143,12 +184,15 @@ func NewClient(apiKey string, opts ...Option) (*Client, error) {
 
 	// Apply defaults.
 	cfg := &config{
-		baseURL:      DefaultBaseURL,
-		timeout:      DefaultTimeout,
-		maxRetries:   DefaultMaxRetries,
-		retryWaitMin: DefaultRetryWaitMin,
-		retryWaitMax: DefaultRetryWaitMax,
-		logger:       noopLogger{},
+		baseURL:         DefaultBaseURL,
+		timeout:         DefaultTimeout,
+		maxRetries:      DefaultMaxRetries,
+		retryWaitMin:    DefaultRetryWaitMin,
+		retryWaitMax:    DefaultRetryWaitMax,
+		logger:          noopLogger{},
+		now:             time.Now,
+		maxConcurrency:  DefaultMaxConcurrency,
+		networkCacheTTL: DefaultNetworkCacheTTL,
 	}
 
 	// Apply options.
@@ -200,23 +244,52 @@ func NewClient(apiKey string, opts ...Option) (*Client, error) {
 	}
 
 	return &Client{
-		apiKey:     apiKey,
-		baseURL:    cfg.baseURL,
-		httpClient: httpClient,
-		logger:     cfg.logger,
+		apiKey:          apiKey,
+		baseURL:         cfg.baseURL,
+		httpClient:      httpClient,
+		logger:          cfg.logger,
+		now:             cfg.now,
+		maxConcurrency:  cfg.maxConcurrency,
+		networkCacheTTL: cfg.networkCacheTTL,
 	}, nil
 }
 
 // doGet performs a GET request to the Birdeye API.
 func (c *Client) doGet(ctx context.Context, path string, params url.Values) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, chainSolana, path, params, nil)
+}
+
+// doPost performs a POST request to the Birdeye API with a JSON body.
+func (c *Client) doPost(ctx context.Context, path string, params url.Values, payload interface{}) ([]byte, error) {
+	return c.doPostChain(ctx, chainSolana, path, params, payload)
+}
+
+// doPostChain performs a POST request with a JSON body against the given
+// chain.
+func (c *Client) doPostChain(ctx context.Context, chain, path string, params url.Values, payload interface{}) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request body: %w", err)
+	}
+	return c.do(ctx, http.MethodPost, chain, path, params, data)
+}
+
+// do performs a request to the Birdeye API against the given chain and
+// returns the response body.
+func (c *Client) do(ctx context.Context, method, chain, path string, params url.Values, payload []byte) ([]byte, error) {
 	// Build request URL.
 	reqURL := c.baseURL + path
 	if len(params) > 0 {
 		reqURL = reqURL + "?" + params.Encode()
 	}
 
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+
 	// Create request with context for cancellation support.
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
@@ -224,9 +297,12 @@ func (c *Client) doGet(ctx context.Context, path string, params url.Values) ([]b
 	// Set required headers.
 	req.Header.Set("X-API-KEY", c.apiKey)
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-chain", chainSolana)
+	req.Header.Set("x-chain", chain)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
-	c.logger.Debug("birdeye api request", "method", http.MethodGet, "path", path)
+	c.logger.Debug("birdeye api request", "method", method, "path", path, "chain", chain)
 
 	// Execute request.
 	resp, err := c.httpClient.Do(req)
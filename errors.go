@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // APIError represents an error response from the Birdeye API.
@@ -53,3 +54,61 @@ func IsAPIError(err error) (*APIError, bool) {
 	}
 	return nil, false
 }
+
+// SimulationError reports that Birdeye processed a simulation request but
+// could not simulate the transaction (e.g., it would revert).
+//
+// It is distinct from APIError, which reports HTTP-level failures, and from
+// transport errors.
+type SimulationError struct {
+	// Message is the reason the simulation failed.
+	Message string
+
+	// Path is the API endpoint that returned the error.
+	Path string
+}
+
+// Error implements the error interface.
+func (e *SimulationError) Error() string {
+	return fmt.Sprintf("birdeye simulation failed: %s: %s", e.Path, e.Message)
+}
+
+// IsSimulationError checks if an error is a simulation failure and returns it.
+// This correctly handles wrapped errors using errors.As.
+func IsSimulationError(err error) (*SimulationError, bool) {
+	var simErr *SimulationError
+	if errors.As(err, &simErr) {
+		return simErr, true
+	}
+	return nil, false
+}
+
+// UnsupportedNetworkError reports that an endpoint does not support the
+// requested chain. It is returned before any request is made to the
+// endpoint itself.
+type UnsupportedNetworkError struct {
+	// Network is the requested chain.
+	Network string
+
+	// Endpoint is the API endpoint that does not support it.
+	Endpoint string
+
+	// Supported are the chains the endpoint does support.
+	Supported []string
+}
+
+// Error implements the error interface.
+func (e *UnsupportedNetworkError) Error() string {
+	return fmt.Sprintf("birdeye: %s does not support network %q (supported: %s)",
+		e.Endpoint, e.Network, strings.Join(e.Supported, ", "))
+}
+
+// IsUnsupportedNetworkError checks if an error is an unsupported network
+// error and returns it. This correctly handles wrapped errors using errors.As.
+func IsUnsupportedNetworkError(err error) (*UnsupportedNetworkError, bool) {
+	var netErr *UnsupportedNetworkError
+	if errors.As(err, &netErr) {
+		return netErr, true
+	}
+	return nil, false
+}
@@ -147,3 +147,53 @@ func TestIsAPIError(t *testing.T) {
 		}
 	})
 }
+
+func TestIsSimulationError(t *testing.T) {
+	t.Run("wrapped SimulationError", func(t *testing.T) {
+		original := &SimulationError{Message: "execution reverted", Path: "/v1/wallet/simulate"}
+		wrapped := fmt.Errorf("simulate swap: %w", original)
+
+		simErr, ok := IsSimulationError(wrapped)
+		if !ok {
+			t.Fatal("expected IsSimulationError to return true for wrapped error")
+		}
+		if simErr.Message != "execution reverted" {
+			t.Errorf("expected message 'execution reverted', got %q", simErr.Message)
+		}
+	})
+
+	t.Run("APIError is not a SimulationError", func(t *testing.T) {
+		_, ok := IsSimulationError(&APIError{StatusCode: 400})
+		if ok {
+			t.Error("expected IsSimulationError to return false for APIError")
+		}
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		_, ok := IsSimulationError(nil)
+		if ok {
+			t.Error("expected IsSimulationError to return false for nil")
+		}
+	})
+}
+
+func TestUnsupportedNetworkError(t *testing.T) {
+	err := &UnsupportedNetworkError{
+		Network:   "tron",
+		Endpoint:  "/defi/v3/search",
+		Supported: []string{"solana", "ethereum"},
+	}
+
+	expected := `birdeye: /defi/v3/search does not support network "tron" (supported: solana, ethereum)`
+	if err.Error() != expected {
+		t.Errorf("expected '%s', got '%s'", expected, err.Error())
+	}
+
+	netErr, ok := IsUnsupportedNetworkError(fmt.Errorf("search: %w", err))
+	if !ok || netErr.Network != "tron" {
+		t.Errorf("expected wrapped UnsupportedNetworkError, got %v", netErr)
+	}
+	if _, ok := IsUnsupportedNetworkError(errors.New("other")); ok {
+		t.Error("expected IsUnsupportedNetworkError to return false for other errors")
+	}
+}
@@ -0,0 +1,183 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxExitLiquidityBatch is the maximum number of addresses per multi
+// exit-liquidity request.
+const MaxExitLiquidityBatch = 50
+
+// ExitLiquidity is the liquidity actually available to sell a token into.
+//
+// Unlike TokenOverview.Liquidity, which counts both sides of every pool,
+// ExitLiquidity only counts the quote-side depth a seller can exit into.
+type ExitLiquidity struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Price is the current price in USD.
+	Price decimal.Decimal `json:"price"`
+
+	// Liquidity is the total liquidity in USD across all pools.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// ExitLiquidity is the liquidity available to sell into, in USD.
+	ExitLiquidity decimal.Decimal `json:"exit_liquidity"`
+}
+
+// MaxPositionSize returns the largest position, in USD, that can be sold
+// into the exit liquidity without exceeding a price impact ceiling.
+//
+// maxImpactPercent is the ceiling as a percentage (e.g., 2 for 2%). The
+// estimate models the exit liquidity as a single constant-product pool,
+// where selling v USD into depth L moves the price by v/(L+v); real pools
+// and routing will differ. Divide by Price for a size in token units.
+// Returns zero if the ceiling is not between 0 and 100 or there is no
+// exit liquidity.
+//
+// Example:
+//
+//	maxUSD := exit.MaxPositionSize(decimal.NewFromInt(2))
+//	if size.GreaterThan(maxUSD) {
+//	    size = maxUSD
+//	}
+func (e ExitLiquidity) MaxPositionSize(maxImpactPercent decimal.Decimal) decimal.Decimal {
+	hundred := decimal.NewFromInt(100)
+	if !maxImpactPercent.IsPositive() || !maxImpactPercent.LessThan(hundred) || !e.ExitLiquidity.IsPositive() {
+		return decimal.Zero
+	}
+
+	impact := maxImpactPercent.Div(hundred)
+	return e.ExitLiquidity.Mul(impact).Div(decimal.NewFromInt(1).Sub(impact))
+}
+
+// GetExitLiquidity fetches the exit liquidity for a token.
+//
+// Example:
+//
+//	exit, err := client.GetExitLiquidity(ctx, tokenAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("$%s of $%s liquidity is exitable", exit.ExitLiquidity, exit.Liquidity)
+func (c *Client) GetExitLiquidity(ctx context.Context, address string) (*ExitLiquidity, error) {
+	const path = "/defi/v3/token/exit-liquidity"
+
+	if address == "" {
+		return nil, &APIError{StatusCode: 400, Message: "address is required", Path: path}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	exit, err := parseResponse[ExitLiquidity](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data for unknown tokens.
+	if exit.Address == "" {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "exit liquidity not found",
+			Path:       path,
+		}
+	}
+
+	c.logger.Debug("fetched exit liquidity",
+		"address", address,
+		"exit_liquidity", exit.ExitLiquidity.String(),
+		"liquidity", exit.Liquidity.String(),
+	)
+
+	return exit, nil
+}
+
+// GetMultipleExitLiquidity fetches the exit liquidity for multiple tokens.
+//
+// Birdeye supports up to 50 addresses per request. This method splits
+// larger lists into batches and fetches them concurrently.
+//
+// Returns a map of address -> exit liquidity. Unknown tokens are omitted.
+//
+// Example:
+//
+//	exits, err := client.GetMultipleExitLiquidity(ctx, addresses)
+//	if err != nil {
+//	    return err
+//	}
+//	for addr, exit := range exits {
+//	    log.Printf("%s: max $%s at 1%% impact", addr, exit.MaxPositionSize(decimal.NewFromInt(1)))
+//	}
+func (c *Client) GetMultipleExitLiquidity(ctx context.Context, addresses []string) (map[string]ExitLiquidity, error) {
+	const path = "/defi/v3/token/exit-liquidity/multiple"
+
+	if len(addresses) == 0 {
+		return make(map[string]ExitLiquidity), nil
+	}
+
+	for _, addr := range addresses {
+		if addr == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "address list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	result, err := fetchBatches(ctx, c, addresses, MaxExitLiquidityBatch,
+		func(ctx context.Context, batch []string) (map[string]ExitLiquidity, error) {
+			params := url.Values{}
+			params.Set("list_address", strings.Join(batch, ","))
+
+			body, err := c.doGet(ctx, path, params)
+			if err != nil {
+				return nil, err
+			}
+
+			page, err := parseResponse[struct {
+				Items []ExitLiquidity `json:"items"`
+			}](body)
+			if err != nil {
+				return nil, err
+			}
+
+			exits := make(map[string]ExitLiquidity, len(page.Items))
+			for _, exit := range page.Items {
+				if exit.Address != "" {
+					exits[exit.Address] = exit
+				}
+			}
+			return exits, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched multiple exit liquidity",
+		"requested", len(addresses),
+		"received", len(result),
+	)
+
+	return result, nil
+}
@@ -0,0 +1,188 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetExitLiquidity_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{
+			"address":        "Token",
+			"symbol":         "TKN",
+			"name":           "Token",
+			"decimals":       6,
+			"price":          0.0123,
+			"liquidity":      "500000.123456",
+			"exit_liquidity": 180000.5,
+		}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	exit, err := client.GetExitLiquidity(context.Background(), "Token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["address"][0] != "Token" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if exit.Symbol != "TKN" || exit.Decimals != 6 {
+		t.Errorf("unexpected exit liquidity: %+v", exit)
+	}
+	if !exit.Liquidity.Equal(decimal.RequireFromString("500000.123456")) {
+		t.Errorf("expected liquidity 500000.123456, got %s", exit.Liquidity)
+	}
+	if !exit.ExitLiquidity.Equal(decimal.RequireFromString("180000.5")) {
+		t.Errorf("expected exit liquidity 180000.5, got %s", exit.ExitLiquidity)
+	}
+}
+
+func TestGetExitLiquidity_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/exit-liquidity": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetExitLiquidity(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected 404 APIError, got %v", err)
+	}
+}
+
+func TestGetExitLiquidity_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	_, err := client.GetExitLiquidity(context.Background(), "")
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetExitLiquidity_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/exit-liquidity": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetExitLiquidity(context.Background(), "Token"); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetMultipleExitLiquidity_Batches(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches []int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/defi/v3/token/exit-liquidity/multiple" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		addrs := strings.Split(r.URL.Query().Get("list_address"), ",")
+		mu.Lock()
+		batches = append(batches, len(addrs))
+		mu.Unlock()
+
+		items := []map[string]interface{}{}
+		for _, addr := range addrs {
+			if addr == "token7" {
+				continue // unknown token
+			}
+			items = append(items, map[string]interface{}{"address": addr, "exit_liquidity": 1000})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": items}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	addresses := make([]string, 120)
+	for i := range addresses {
+		addresses[i] = "token" + strconv.Itoa(i)
+	}
+
+	exits, err := client.GetMultipleExitLiquidity(context.Background(), addresses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(exits) != 119 {
+		t.Errorf("expected 119 entries, got %d", len(exits))
+	}
+	if _, ok := exits["token7"]; ok {
+		t.Error("expected unknown token to be omitted")
+	}
+	if !exits["token42"].ExitLiquidity.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("unexpected exit liquidity: %+v", exits["token42"])
+	}
+	if len(batches) != 3 {
+		t.Errorf("expected 3 batches, got %v", batches)
+	}
+	for _, n := range batches {
+		if n > MaxExitLiquidityBatch {
+			t.Errorf("batch of %d exceeds limit", n)
+		}
+	}
+}
+
+func TestGetMultipleExitLiquidity_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	if _, err := client.GetMultipleExitLiquidity(context.Background(), []string{"a", ""}); err == nil {
+		t.Error("expected error for empty address")
+	}
+
+	exits, err := client.GetMultipleExitLiquidity(context.Background(), nil)
+	if err != nil || len(exits) != 0 {
+		t.Errorf("expected empty result for empty list, got %v, %v", exits, err)
+	}
+}
+
+func TestExitLiquidity_MaxPositionSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		exit     string
+		impact   string
+		expected string
+	}{
+		// v/(L+v) = 0.5 at v = L.
+		{"half impact", "100000", "50", "100000"},
+		// 0.02 * 99000 / 0.98 = 2020.408...
+		{"two percent", "99000", "2", "2020.4081632653061224"},
+		{"zero impact", "100000", "0", "0"},
+		{"full impact", "100000", "100", "0"},
+		{"negative impact", "100000", "-1", "0"},
+		{"no liquidity", "0", "2", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exit := ExitLiquidity{ExitLiquidity: decimal.RequireFromString(tt.exit)}
+			got := exit.MaxPositionSize(decimal.RequireFromString(tt.impact))
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package birdeye
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// flatFields decodes individual fields from a flat JSON object.
+// The first decoding error is kept in err; later accessors return zero
+// values for malformed fields.
+type flatFields struct {
+	raw map[string]json.RawMessage
+	err error
+}
+
+// newFlatFields parses a JSON object for field-by-field decoding.
+func newFlatFields(data []byte) (*flatFields, error) {
+	f := &flatFields{}
+	if err := json.Unmarshal(data, &f.raw); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// setErr records the first decoding error.
+func (f *flatFields) setErr(key string, err error) {
+	if f.err == nil {
+		f.err = fmt.Errorf("decode field %q: %w", key, err)
+	}
+}
+
+// string returns the string field key, or "" if absent or null.
+func (f *flatFields) string(key string) string {
+	var s string
+	if v, ok := f.raw[key]; ok && string(v) != "null" {
+		if err := json.Unmarshal(v, &s); err != nil {
+			f.setErr(key, err)
+		}
+	}
+	return s
+}
+
+// decimal returns the numeric field key, or zero if absent or null.
+func (f *flatFields) decimal(key string) decimal.Decimal {
+	var d decimal.Decimal
+	if v, ok := f.raw[key]; ok {
+		if err := d.UnmarshalJSON(v); err != nil {
+			f.setErr(key, err)
+		}
+	}
+	return d
+}
+
+// int returns the numeric field key truncated to an int, or zero if absent.
+func (f *flatFields) int(key string) int {
+	return int(f.decimal(key).IntPart())
+}
+
+// object decodes the object field key into v, leaving v unchanged if absent.
+func (f *flatFields) object(key string, v interface{}) {
+	if raw, ok := f.raw[key]; ok {
+		if err := json.Unmarshal(raw, v); err != nil {
+			f.setErr(key, err)
+		}
+	}
+}
+
+// has reports whether any of the keys is present.
+func (f *flatFields) has(keys ...string) bool {
+	for _, k := range keys {
+		if _, ok := f.raw[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// windows groups the per-timeframe statistics. Timeframes with no fields
+// present are omitted.
+func (f *flatFields) windows(timeframes []Timeframe) map[Timeframe]TradeStats {
+	windows := make(map[Timeframe]TradeStats, len(timeframes))
+
+	for _, tf := range timeframes {
+		key := func(format string) string { return fmt.Sprintf(format, tf) }
+
+		if !f.has(key("trade_%s"), key("volume_%s"), key("volume_%s_usd"), key("unique_wallet_%s")) {
+			continue
+		}
+
+		windows[tf] = TradeStats{
+			HistoryPrice:              f.decimal(key("history_%s_price")),
+			PriceChangePercent:        f.decimal(key("price_change_%s_percent")),
+			UniqueWallet:              f.int(key("unique_wallet_%s")),
+			UniqueWalletChangePercent: f.decimal(key("unique_wallet_%s_change_percent")),
+			Trade:                     f.int(key("trade_%s")),
+			TradeChangePercent:        f.decimal(key("trade_%s_change_percent")),
+			Buy:                       f.int(key("buy_%s")),
+			BuyChangePercent:          f.decimal(key("buy_%s_change_percent")),
+			Sell:                      f.int(key("sell_%s")),
+			SellChangePercent:         f.decimal(key("sell_%s_change_percent")),
+			Volume:                    f.decimal(key("volume_%s")),
+			VolumeUSD:                 f.decimal(key("volume_%s_usd")),
+			VolumeChangePercent:       f.decimal(key("volume_%s_change_percent")),
+			VolumeBuy:                 f.decimal(key("volume_buy_%s")),
+			VolumeBuyUSD:              f.decimal(key("volume_buy_%s_usd")),
+			VolumeSell:                f.decimal(key("volume_sell_%s")),
+			VolumeSellUSD:             f.decimal(key("volume_sell_%s_usd")),
+		}
+	}
+
+	return windows
+}
@@ -0,0 +1,58 @@
+package birdeye
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestFlatFields_Accessors(t *testing.T) {
+	f, err := newFlatFields([]byte(`{"name": "Token", "empty": null, "price": "1.25", "count": 7.9, "meta": {"a": 1}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if f.string("name") != "Token" || f.string("empty") != "" || f.string("missing") != "" {
+		t.Error("unexpected string values")
+	}
+	if !f.decimal("price").Equal(decimal.RequireFromString("1.25")) {
+		t.Errorf("expected price 1.25, got %s", f.decimal("price"))
+	}
+	if f.int("count") != 7 {
+		t.Errorf("expected count 7, got %d", f.int("count"))
+	}
+
+	var meta struct{ A int }
+	f.object("meta", &meta)
+	if meta.A != 1 {
+		t.Errorf("unexpected object: %+v", meta)
+	}
+
+	if !f.has("missing", "name") || f.has("missing") {
+		t.Error("unexpected has result")
+	}
+	if f.err != nil {
+		t.Errorf("unexpected decode error: %v", f.err)
+	}
+}
+
+func TestFlatFields_KeepsFirstError(t *testing.T) {
+	f, err := newFlatFields([]byte(`{"a": "x", "b": true}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !f.decimal("a").IsZero() || !f.decimal("b").IsZero() {
+		t.Error("expected zero values for malformed fields")
+	}
+	if f.err == nil || !strings.Contains(f.err.Error(), `"a"`) {
+		t.Errorf("expected first error for field a, got %v", f.err)
+	}
+}
+
+func TestNewFlatFields_NotObject(t *testing.T) {
+	if _, err := newFlatFields([]byte(`[1, 2]`)); err == nil {
+		t.Error("expected error for non-object input")
+	}
+}
@@ -0,0 +1,247 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxMarketLimit is the maximum number of markets Birdeye returns per page.
+const MaxMarketLimit = 20
+
+// MarketSortField is the field token markets are sorted by.
+type MarketSortField string
+
+// Supported market sort fields.
+const (
+	MarketSortLiquidity MarketSortField = "liquidity"
+	MarketSortVolume24h MarketSortField = "volume24h"
+)
+
+// Market is a single liquidity pool trading a token.
+type Market struct {
+	// Address is the pool address.
+	Address string `json:"address"`
+
+	// Name is the pool's display name (e.g., "SOL-USDC").
+	Name string `json:"name"`
+
+	// Source is the DEX the pool belongs to (e.g., "Raydium", "Orca").
+	Source string `json:"source"`
+
+	// Base is the pool's base token.
+	Base MarketToken `json:"base"`
+
+	// Quote is the pool's quote token.
+	Quote MarketToken `json:"quote"`
+
+	// CreatedAt is when the pool was created, as returned by Birdeye.
+	CreatedAt string `json:"createdAt"`
+
+	// Liquidity is the pool's liquidity in USD.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// Price is the pool's base token price in quote token units.
+	Price decimal.Decimal `json:"price"`
+
+	// Volume24h is the pool's 24-hour trading volume in USD.
+	Volume24h decimal.Decimal `json:"volume24h"`
+
+	// Trade24h is the number of trades in the last 24 hours.
+	Trade24h int `json:"trade24h"`
+
+	// Trade24hChangePercent is the change in trade count vs previous 24h.
+	Trade24hChangePercent decimal.Decimal `json:"trade24hChangePercent"`
+
+	// UniqueWallet24h is the number of unique wallets trading in 24h.
+	UniqueWallet24h int `json:"uniqueWallet24h"`
+
+	// UniqueWallet24hChangePercent is the change vs previous 24h.
+	UniqueWallet24hChangePercent decimal.Decimal `json:"uniqueWallet24hChangePercent"`
+}
+
+// MarketToken is one token of a liquidity pool.
+type MarketToken struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Icon is a URL to the token's logo image.
+	Icon string `json:"icon"`
+}
+
+// MarketPage is a single page of a token's markets.
+type MarketPage struct {
+	// Items are the markets in this page.
+	Items []Market `json:"items"`
+
+	// Total is the total number of markets for the token.
+	Total int `json:"total"`
+}
+
+// MarketOptions configures token market requests.
+//
+// A nil *MarketOptions returns the deepest pools first.
+type MarketOptions struct {
+	// SortBy is the field to sort by. Empty uses MarketSortLiquidity.
+	SortBy MarketSortField
+
+	// SortType is the sort direction. Empty uses desc.
+	SortType SortType
+
+	// Timeframe is the window for trade statistics. Empty uses 24h.
+	Timeframe Timeframe
+
+	// Offset is the number of markets to skip.
+	Offset int
+
+	// Limit is the page size (1-20). Zero uses the maximum.
+	Limit int
+
+	// MaxItems caps the number of markets yielded by AllTokenMarkets.
+	// Zero means no cap. It is ignored by GetTokenMarkets.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *MarketOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxMarketLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 20", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a token markets request.
+func (o *MarketOptions) params(address string) url.Values {
+	params := url.Values{}
+	params.Set("address", address)
+	params.Set("sort_by", string(MarketSortLiquidity))
+	params.Set("sort_type", string(SortDesc))
+	params.Set("time_frame", string(Timeframe24h))
+
+	limit := MaxMarketLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.SortBy != "" {
+		params.Set("sort_by", string(o.SortBy))
+	}
+	if o.SortType != "" {
+		params.Set("sort_type", string(o.SortType))
+	}
+	if o.Timeframe != "" {
+		params.Set("time_frame", string(o.Timeframe))
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *MarketOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// GetTokenMarkets fetches a page of the liquidity pools trading a token.
+//
+// Example:
+//
+//	page, err := client.GetTokenMarkets(ctx, tokenAddress, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, market := range page.Items {
+//	    log.Printf("%s on %s: $%s liquidity", market.Name, market.Source, market.Liquidity)
+//	}
+func (c *Client) GetTokenMarkets(ctx context.Context, address string, opts *MarketOptions) (*MarketPage, error) {
+	const path = "/defi/v2/markets"
+
+	if address == "" {
+		return nil, &APIError{StatusCode: 400, Message: "address is required", Path: path}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(address))
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := parseResponse[MarketPage](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched token markets",
+		"address", address,
+		"count", len(page.Items),
+		"total", page.Total,
+	)
+
+	return page, nil
+}
+
+// AllTokenMarkets returns a lazy iterator over every liquidity pool trading
+// a token, fetching further pages as the caller consumes them.
+func (c *Client) AllTokenMarkets(ctx context.Context, address string, opts *MarketOptions) iter.Seq2[Market, error] {
+	page := iteratorOptions(opts, MaxMarketLimit)
+
+	return paginate(ctx, page.Offset, page.MaxItems, func(ctx context.Context, offset int) ([]Market, bool, error) {
+		pageOpts := page
+		pageOpts.Offset = offset
+
+		markets, err := c.GetTokenMarkets(ctx, address, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		return markets.Items, offset+len(markets.Items) < markets.Total, nil
+	})
+}
+
+// DeepestMarket returns the market with the most USD liquidity that pairs
+// against the given counter token, which may be on either side of the pool.
+// It reports false if no market trades against that token.
+//
+// Example:
+//
+//	const usdc = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+//	if pool, ok := birdeye.DeepestMarket(page.Items, usdc); ok {
+//	    log.Printf("route via %s on %s", pool.Address, pool.Source)
+//	}
+func DeepestMarket(markets []Market, quoteAddress string) (Market, bool) {
+	var best Market
+	found := false
+
+	for _, m := range markets {
+		if m.Quote.Address != quoteAddress && m.Base.Address != quoteAddress {
+			continue
+		}
+		if !found || m.Liquidity.GreaterThan(best.Liquidity) {
+			best, found = m, true
+		}
+	}
+
+	return best, found
+}
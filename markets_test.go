@@ -0,0 +1,208 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetTokenMarkets_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v2/markets": wrapResponse(map[string]interface{}{
+			"total": 1,
+			"items": []map[string]interface{}{
+				{
+					"address": "PoolAddr",
+					"name":    "BONK-SOL",
+					"source":  "Raydium",
+					"base": map[string]interface{}{
+						"address":  "BonkMint",
+						"symbol":   "BONK",
+						"decimals": 5,
+						"icon":     "https://example.com/bonk.png",
+					},
+					"quote": map[string]interface{}{
+						"address":  "So11111111111111111111111111111111111111112",
+						"symbol":   "SOL",
+						"decimals": 9,
+					},
+					"createdAt":                    "2023-12-24T16:15:49.407Z",
+					"liquidity":                    4271311.123456,
+					"price":                        0.0000001234,
+					"volume24h":                    987654.32,
+					"trade24h":                     3456,
+					"trade24hChangePercent":        -4.5,
+					"uniqueWallet24h":              789,
+					"uniqueWallet24hChangePercent": 2.25,
+				},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	page, err := client.GetTokenMarkets(context.Background(), "BonkMint", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if page.Total != 1 || len(page.Items) != 1 {
+		t.Fatalf("expected 1 market, got %d of %d", len(page.Items), page.Total)
+	}
+
+	market := page.Items[0]
+	if market.Source != "Raydium" || market.Base.Symbol != "BONK" || market.Quote.Decimals != 9 {
+		t.Errorf("unexpected market: %+v", market)
+	}
+	if !market.Liquidity.Equal(decimal.RequireFromString("4271311.123456")) {
+		t.Errorf("expected liquidity 4271311.123456, got %s", market.Liquidity)
+	}
+	if market.Trade24h != 3456 || market.UniqueWallet24h != 789 {
+		t.Errorf("unexpected trade counts: %+v", market)
+	}
+}
+
+func TestGetTokenMarkets_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": [], "total": 0}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenMarkets(context.Background(), "Token", &MarketOptions{
+		SortBy:    MarketSortVolume24h,
+		SortType:  SortAsc,
+		Timeframe: Timeframe4h,
+		Offset:    20,
+		Limit:     5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"address":    "Token",
+		"sort_by":    "volume24h",
+		"sort_type":  "asc",
+		"time_frame": "4h",
+		"offset":     "20",
+		"limit":      "5",
+	}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestGetTokenMarkets_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name    string
+		address string
+		opts    *MarketOptions
+	}{
+		{"empty address", "", nil},
+		{"negative offset", "Token", &MarketOptions{Offset: -1}},
+		{"limit too large", "Token", &MarketOptions{Limit: 21}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetTokenMarkets(context.Background(), tt.address, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetTokenMarkets_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v2/markets": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetTokenMarkets(context.Background(), "Token", nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllTokenMarkets_Paginates(t *testing.T) {
+	const total = 45
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, map[string]interface{}{"address": "Pool" + strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": items, "total": total}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	count := 0
+	for _, err := range client.AllTokenMarkets(context.Background(), "Token", nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		count++
+	}
+
+	if count != total {
+		t.Errorf("expected %d markets, got %d", total, count)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestDeepestMarket(t *testing.T) {
+	const sol, usdc = "SOL", "USDC"
+	markets := []Market{
+		{Address: "small-sol", Base: MarketToken{Address: "T"}, Quote: MarketToken{Address: sol}, Liquidity: decimal.NewFromInt(1000)},
+		{Address: "big-usdc", Base: MarketToken{Address: "T"}, Quote: MarketToken{Address: usdc}, Liquidity: decimal.NewFromInt(9000)},
+		{Address: "big-sol", Base: MarketToken{Address: sol}, Quote: MarketToken{Address: "T"}, Liquidity: decimal.NewFromInt(5000)},
+	}
+
+	tests := []struct {
+		quote    string
+		expected string
+		found    bool
+	}{
+		{sol, "big-sol", true},
+		{usdc, "big-usdc", true},
+		{"USDT", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.quote, func(t *testing.T) {
+			market, ok := DeepestMarket(markets, tt.quote)
+			if ok != tt.found {
+				t.Fatalf("expected found=%v, got %v", tt.found, ok)
+			}
+			if market.Address != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, market.Address)
+			}
+		})
+	}
+}
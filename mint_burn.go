@@ -0,0 +1,302 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxMintBurnLimit is the maximum number of mint/burn transactions Birdeye
+// returns per page.
+const MaxMintBurnLimit = 100
+
+// MintBurnType is the kind of supply change in a mint/burn transaction.
+type MintBurnType string
+
+// Supported mint/burn types. MintBurnAll is only valid as a filter.
+const (
+	MintBurnAll  MintBurnType = "all"
+	MintBurnMint MintBurnType = "mint"
+	MintBurnBurn MintBurnType = "burn"
+)
+
+// MintBurnTx is a single transaction that minted or burned a token.
+type MintBurnTx struct {
+	// TxHash is the transaction signature.
+	TxHash string `json:"tx_hash"`
+
+	// Slot is the slot the transaction landed in.
+	Slot uint64 `json:"slot"`
+
+	// Type is whether the transaction minted or burned tokens.
+	Type MintBurnType `json:"common_type"`
+
+	// Mint is the token's mint address.
+	Mint string `json:"mint"`
+
+	// ProgramID is the token program that executed the instruction.
+	ProgramID string `json:"program_id"`
+
+	// Authority is the account that signed the mint or burn, when reported.
+	Authority string `json:"authority"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Amount is the raw amount minted or burned (not adjusted for decimals).
+	Amount decimal.Decimal `json:"amount"`
+
+	// UIAmount is the amount adjusted for decimals.
+	UIAmount decimal.Decimal `json:"-"`
+
+	// BlockUnixTime is the block time of the transaction (Unix timestamp).
+	BlockUnixTime int64 `json:"block_time"`
+
+	// BlockHumanTime is a human-readable block timestamp.
+	BlockHumanTime string `json:"block_human_time"`
+}
+
+// Time returns the transaction's block time in UTC.
+func (tx *MintBurnTx) Time() time.Time {
+	return time.Unix(tx.BlockUnixTime, 0).UTC()
+}
+
+// MintBurnOptions configures mint/burn transaction requests.
+//
+// A nil *MintBurnOptions returns the most recent mints and burns.
+type MintBurnOptions struct {
+	// Type filters by mint or burn. Empty uses MintBurnAll.
+	Type MintBurnType
+
+	// SortType is the sort direction by block time. Empty uses desc.
+	SortType SortType
+
+	// AfterTime limits results to transactions at or after this time.
+	// Zero means no lower bound.
+	AfterTime time.Time
+
+	// BeforeTime limits results to transactions at or before this time.
+	// Zero means no upper bound.
+	BeforeTime time.Time
+
+	// Offset is the number of transactions to skip.
+	Offset int
+
+	// Limit is the page size (1-100). Zero uses the maximum.
+	Limit int
+
+	// MaxItems caps the number of transactions yielded by AllMintBurnTxs.
+	// Zero means no cap. It is ignored by ListMintBurnTxs.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *MintBurnOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxMintBurnLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 100", Path: path}
+	}
+	switch o.Type {
+	case "", MintBurnAll, MintBurnMint, MintBurnBurn:
+	default:
+		return &APIError{StatusCode: 400, Message: "unsupported mint/burn type: " + string(o.Type), Path: path}
+	}
+	if !o.BeforeTime.IsZero() && !o.AfterTime.IsZero() && o.AfterTime.After(o.BeforeTime) {
+		return &APIError{StatusCode: 400, Message: "after time must not be after before time", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a mint/burn request.
+func (o *MintBurnOptions) params(address string) url.Values {
+	params := url.Values{}
+	params.Set("address", address)
+	params.Set("sort_by", "block_time")
+	params.Set("sort_type", string(SortDesc))
+	params.Set("type", string(MintBurnAll))
+
+	limit := MaxMintBurnLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.Type != "" {
+		params.Set("type", string(o.Type))
+	}
+	if o.SortType != "" {
+		params.Set("sort_type", string(o.SortType))
+	}
+	if !o.AfterTime.IsZero() {
+		params.Set("after_time", strconv.FormatInt(o.AfterTime.Unix(), 10))
+	}
+	if !o.BeforeTime.IsZero() {
+		params.Set("before_time", strconv.FormatInt(o.BeforeTime.Unix(), 10))
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *MintBurnOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// ListMintBurnTxs fetches a page of transactions that minted or burned a
+// token, most recent first by default.
+//
+// An active mint authority (see TokenSecurity.HasMintAuthority) only shows
+// that minting is possible; this shows whether it has happened.
+//
+// Example:
+//
+//	mints, err := client.ListMintBurnTxs(ctx, tokenAddress, &birdeye.MintBurnOptions{
+//	    Type:      birdeye.MintBurnMint,
+//	    AfterTime: time.Now().Add(-7 * 24 * time.Hour),
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	if len(mints) > 0 {
+//	    log.Warn("supply minted in the last week", "tx", mints[0].TxHash)
+//	}
+func (c *Client) ListMintBurnTxs(ctx context.Context, address string, opts *MintBurnOptions) ([]MintBurnTx, error) {
+	const path = "/defi/v3/token/mint-burn-txs"
+
+	if address == "" {
+		return nil, &APIError{StatusCode: 400, Message: "address is required", Path: path}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(address))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseResponse[struct {
+		Items []MintBurnTx `json:"items"`
+	}](body)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Items {
+		tx := &resp.Items[i]
+		tx.UIAmount = uiAmount(tx.Amount, tx.Decimals)
+	}
+
+	c.logger.Debug("fetched mint/burn transactions",
+		"address", address,
+		"count", len(resp.Items),
+	)
+
+	return resp.Items, nil
+}
+
+// AllMintBurnTxs returns a lazy iterator over a token's mint and burn
+// transactions, fetching further pages as the caller consumes them.
+//
+// Iteration ends when a page returns fewer transactions than the page
+// size. Set opts.MaxItems to bound the total number of transactions.
+//
+// Example:
+//
+//	opts := &birdeye.MintBurnOptions{AfterTime: time.Now().Add(-30 * 24 * time.Hour)}
+//	var txs []birdeye.MintBurnTx
+//	for tx, err := range client.AllMintBurnTxs(ctx, tokenAddress, opts) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    txs = append(txs, tx)
+//	}
+//	change := birdeye.SummarizeSupplyChange(txs, opts.AfterTime, time.Time{})
+func (c *Client) AllMintBurnTxs(ctx context.Context, address string, opts *MintBurnOptions) iter.Seq2[MintBurnTx, error] {
+	page := iteratorOptions(opts, MaxMintBurnLimit)
+
+	return paginate(ctx, page.Offset, page.MaxItems, func(ctx context.Context, offset int) ([]MintBurnTx, bool, error) {
+		pageOpts := page
+		pageOpts.Offset = offset
+
+		txs, err := c.ListMintBurnTxs(ctx, address, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		return txs, len(txs) == page.Limit, nil
+	})
+}
+
+// SupplyChange summarizes the mints and burns of a token over a window.
+type SupplyChange struct {
+	// Minted is the total amount minted, adjusted for decimals.
+	Minted decimal.Decimal
+
+	// Burned is the total amount burned, adjusted for decimals.
+	Burned decimal.Decimal
+
+	// Net is Minted minus Burned. Positive means supply grew.
+	Net decimal.Decimal
+
+	// Mints is the number of mint transactions.
+	Mints int
+
+	// Burns is the number of burn transactions.
+	Burns int
+}
+
+// SummarizeSupplyChange totals the mints and burns in txs whose block time
+// falls within [start, end).
+//
+// A zero start or end leaves that side of the window unbounded.
+//
+// Example:
+//
+//	change := birdeye.SummarizeSupplyChange(txs, time.Now().Add(-24*time.Hour), time.Time{})
+//	if change.Net.IsPositive() {
+//	    log.Warn("supply inflated", "minted", change.Minted, "burned", change.Burned)
+//	}
+func SummarizeSupplyChange(txs []MintBurnTx, start, end time.Time) SupplyChange {
+	change := SupplyChange{
+		Minted: decimal.Zero,
+		Burned: decimal.Zero,
+	}
+
+	for i := range txs {
+		tx := &txs[i]
+		at := tx.Time()
+		if !start.IsZero() && at.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !at.Before(end) {
+			continue
+		}
+
+		switch tx.Type {
+		case MintBurnMint:
+			change.Minted = change.Minted.Add(tx.UIAmount)
+			change.Mints++
+		case MintBurnBurn:
+			change.Burned = change.Burned.Add(tx.UIAmount)
+			change.Burns++
+		}
+	}
+
+	change.Net = change.Minted.Sub(change.Burned)
+	return change
+}
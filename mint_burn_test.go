@@ -0,0 +1,213 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestListMintBurnTxs_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/mint-burn-txs": wrapResponse(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{
+					"amount":           "5000000000000",
+					"block_human_time": "2024-11-05T10:15:30",
+					"block_time":       1730801730,
+					"common_type":      "mint",
+					"decimals":         6,
+					"mint":             "TokenMint123",
+					"program_id":       "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+					"slot":             299999999,
+					"tx_hash":          "MintTx",
+					"ui_amount":        5000000.000000001,
+				},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	txs, err := client.ListMintBurnTxs(context.Background(), "TokenMint123", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(txs) != 1 {
+		t.Fatalf("expected 1 transaction, got %d", len(txs))
+	}
+
+	tx := txs[0]
+	if tx.Type != MintBurnMint || tx.TxHash != "MintTx" || tx.Slot != 299999999 {
+		t.Errorf("unexpected transaction: %+v", tx)
+	}
+	if tx.UIAmount.String() != "5000000" {
+		t.Errorf("expected ui amount 5000000, got %s", tx.UIAmount)
+	}
+	if !tx.Time().Equal(time.Unix(1730801730, 0)) {
+		t.Errorf("unexpected time: %v", tx.Time())
+	}
+}
+
+func TestListMintBurnTxs_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": []}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListMintBurnTxs(context.Background(), "Token", &MintBurnOptions{
+		Type:       MintBurnBurn,
+		SortType:   SortAsc,
+		AfterTime:  time.Unix(1700000000, 0),
+		BeforeTime: time.Unix(1700086400, 0),
+		Offset:     100,
+		Limit:      25,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"address":     "Token",
+		"type":        "burn",
+		"sort_by":     "block_time",
+		"sort_type":   "asc",
+		"after_time":  "1700000000",
+		"before_time": "1700086400",
+		"offset":      "100",
+		"limit":       "25",
+	}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestListMintBurnTxs_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name    string
+		address string
+		opts    *MintBurnOptions
+	}{
+		{"empty address", "", nil},
+		{"negative offset", "Token", &MintBurnOptions{Offset: -1}},
+		{"limit too large", "Token", &MintBurnOptions{Limit: 101}},
+		{"unknown type", "Token", &MintBurnOptions{Type: "transfer"}},
+		{"inverted window", "Token", &MintBurnOptions{AfterTime: time.Unix(200, 0), BeforeTime: time.Unix(100, 0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListMintBurnTxs(context.Background(), tt.address, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestListMintBurnTxs_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/mint-burn-txs": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.ListMintBurnTxs(context.Background(), "Token", nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllMintBurnTxs_Paginates(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		// 23 transactions in total.
+		items := []map[string]interface{}{}
+		for i := offset; i < offset+10 && i < 23; i++ {
+			items = append(items, map[string]interface{}{"tx_hash": "tx" + strconv.Itoa(i), "common_type": "burn"})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": items}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	count := 0
+	for tx, err := range client.AllMintBurnTxs(context.Background(), "Token", &MintBurnOptions{Limit: 10}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tx.TxHash != "tx"+strconv.Itoa(count) {
+			t.Errorf("expected tx%d, got %s", count, tx.TxHash)
+		}
+		count++
+	}
+
+	if count != 23 {
+		t.Errorf("expected 23 transactions, got %d", count)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestSummarizeSupplyChange(t *testing.T) {
+	day := time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)
+	at := func(h int) int64 { return day.Add(time.Duration(h) * time.Hour).Unix() }
+
+	txs := []MintBurnTx{
+		{Type: MintBurnMint, UIAmount: decimal.NewFromInt(1000), BlockUnixTime: at(-1)},
+		{Type: MintBurnMint, UIAmount: decimal.NewFromInt(500), BlockUnixTime: at(0)},
+		{Type: MintBurnBurn, UIAmount: decimal.NewFromInt(200), BlockUnixTime: at(6)},
+		{Type: MintBurnBurn, UIAmount: decimal.NewFromInt(50), BlockUnixTime: at(24)},
+	}
+
+	tests := []struct {
+		name         string
+		start, end   time.Time
+		minted       string
+		burned       string
+		net          string
+		mints, burns int
+	}{
+		{"unbounded", time.Time{}, time.Time{}, "1500", "250", "1250", 2, 2},
+		{"start inclusive, end exclusive", day, day.Add(24 * time.Hour), "500", "200", "300", 1, 1},
+		{"burns only", day.Add(time.Hour), time.Time{}, "0", "250", "-250", 0, 2},
+		{"empty window", day.Add(48 * time.Hour), time.Time{}, "0", "0", "0", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SummarizeSupplyChange(txs, tt.start, tt.end)
+			if !got.Minted.Equal(decimal.RequireFromString(tt.minted)) ||
+				!got.Burned.Equal(decimal.RequireFromString(tt.burned)) ||
+				!got.Net.Equal(decimal.RequireFromString(tt.net)) {
+				t.Errorf("expected minted %s, burned %s, net %s; got %s, %s, %s",
+					tt.minted, tt.burned, tt.net, got.Minted, got.Burned, got.Net)
+			}
+			if got.Mints != tt.mints || got.Burns != tt.burns {
+				t.Errorf("expected %d mints and %d burns, got %d and %d", tt.mints, tt.burns, got.Mints, got.Burns)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package birdeye
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Network listing endpoints.
+const (
+	networksPath       = "/defi/networks"
+	walletNetworksPath = "/v1/wallet/list_supported_chain"
+)
+
+// endpointNetworks lists endpoints Birdeye documents as serving fewer
+// chains than its general listing. Endpoints not listed here, and outside
+// /v1/wallet/, are assumed to serve every chain in ListNetworks.
+var endpointNetworks = map[string][]string{
+	"/defi/token_creation_info":    {chainSolana},
+	"/defi/v3/token/mint-burn-txs": {chainSolana},
+}
+
+// networkCache caches network listings by listing path.
+type networkCache struct {
+	mu      sync.Mutex
+	entries map[string]networkCacheEntry
+}
+
+// networkCacheEntry is a cached network listing.
+type networkCacheEntry struct {
+	networks  []string
+	fetchedAt time.Time
+}
+
+// ListNetworks fetches the chains Birdeye supports.
+//
+// Results are cached for the duration set by WithNetworkCacheTTL.
+//
+// Example:
+//
+//	networks, err := client.ListNetworks(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("birdeye supports %s", strings.Join(networks, ", "))
+func (c *Client) ListNetworks(ctx context.Context) ([]string, error) {
+	return c.cachedNetworks(ctx, networksPath)
+}
+
+// SupportedNetworks fetches the chains supported by an endpoint, given as
+// its API path (e.g., "/v1/wallet/token_list").
+//
+// Wallet endpoints (under /v1/wallet/) publish their own listing, and a
+// few endpoints Birdeye documents as single-chain (e.g., mint/burn
+// transactions on Solana) are answered from a built-in table without a
+// request. Every other endpoint falls back to the general listing from
+// ListNetworks, which may include chains that endpoint does not serve.
+// Results are cached for the duration set by WithNetworkCacheTTL.
+func (c *Client) SupportedNetworks(ctx context.Context, endpoint string) ([]string, error) {
+	if endpoint == "" {
+		return nil, &APIError{StatusCode: 400, Message: "endpoint is required", Path: networksPath}
+	}
+
+	if networks, ok := endpointNetworks[endpoint]; ok {
+		return slices.Clone(networks), nil
+	}
+	if strings.HasPrefix(endpoint, "/v1/wallet/") {
+		return c.cachedNetworks(ctx, walletNetworksPath)
+	}
+	return c.cachedNetworks(ctx, networksPath)
+}
+
+// CheckNetwork returns an *UnsupportedNetworkError if the endpoint does not
+// support the network, so callers can fail fast before making the call.
+//
+// It is only as precise as SupportedNetworks; see there for how an
+// endpoint's chains are determined.
+//
+// Example:
+//
+//	if err := client.CheckNetwork(ctx, "/v1/wallet/token_list", chain); err != nil {
+//	    return err
+//	}
+func (c *Client) CheckNetwork(ctx context.Context, endpoint, network string) error {
+	supported, err := c.SupportedNetworks(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(supported, network) {
+		return &UnsupportedNetworkError{
+			Network:   network,
+			Endpoint:  endpoint,
+			Supported: supported,
+		}
+	}
+	return nil
+}
+
+// cachedNetworks returns the network listing at path, from the cache if
+// it is fresh.
+func (c *Client) cachedNetworks(ctx context.Context, path string) ([]string, error) {
+	c.networks.mu.Lock()
+	entry, ok := c.networks.entries[path]
+	c.networks.mu.Unlock()
+
+	if ok && c.networkCacheTTL > 0 && c.now().Sub(entry.fetchedAt) < c.networkCacheTTL {
+		return slices.Clone(entry.networks), nil
+	}
+
+	body, err := c.doGet(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	networks, err := parseResponse[[]string](body)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.networkCacheTTL > 0 {
+		c.networks.mu.Lock()
+		if c.networks.entries == nil {
+			c.networks.entries = make(map[string]networkCacheEntry)
+		}
+		c.networks.entries[path] = networkCacheEntry{networks: *networks, fetchedAt: c.now()}
+		c.networks.mu.Unlock()
+	}
+
+	c.logger.Debug("fetched supported networks",
+		"path", path,
+		"count", len(*networks),
+	)
+
+	return slices.Clone(*networks), nil
+}
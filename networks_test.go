@@ -0,0 +1,187 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+// networksServer serves the general and wallet network listings, counting
+// requests per path.
+func networksServer(t *testing.T) (*httptest.Server, map[string]int) {
+	t.Helper()
+
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/defi/networks":
+			_, _ = w.Write([]byte(`{"success": true, "data": ["solana", "ethereum", "arbitrum", "base"]}`))
+		case "/v1/wallet/list_supported_chain":
+			_, _ = w.Write([]byte(`{"success": true, "data": ["solana", "ethereum"]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	return server, requests
+}
+
+func TestListNetworks_Success(t *testing.T) {
+	server, _ := networksServer(t)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	networks, err := client.ListNetworks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !slices.Equal(networks, []string{"solana", "ethereum", "arbitrum", "base"}) {
+		t.Errorf("unexpected networks: %v", networks)
+	}
+}
+
+func TestListNetworks_Caches(t *testing.T) {
+	server, requests := networksServer(t)
+	defer server.Close()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := testClient(t, server.URL, WithClock(func() time.Time { return now }), WithNetworkCacheTTL(time.Hour))
+
+	for i := 0; i < 3; i++ {
+		networks, err := client.ListNetworks(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Callers must not be able to corrupt the cache.
+		networks[0] = "mutated"
+	}
+	if requests["/defi/networks"] != 1 {
+		t.Errorf("expected 1 request while cached, got %d", requests["/defi/networks"])
+	}
+
+	networks, _ := client.ListNetworks(context.Background())
+	if networks[0] != "solana" {
+		t.Errorf("cache was mutated: %v", networks)
+	}
+
+	now = now.Add(time.Hour)
+	if _, err := client.ListNetworks(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests["/defi/networks"] != 2 {
+		t.Errorf("expected refetch after TTL, got %d requests", requests["/defi/networks"])
+	}
+}
+
+func TestListNetworks_CacheDisabled(t *testing.T) {
+	server, requests := networksServer(t)
+	defer server.Close()
+
+	client := testClient(t, server.URL, WithNetworkCacheTTL(0))
+	for i := 0; i < 2; i++ {
+		if _, err := client.ListNetworks(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if requests["/defi/networks"] != 2 {
+		t.Errorf("expected 2 requests with caching disabled, got %d", requests["/defi/networks"])
+	}
+}
+
+func TestSupportedNetworks_WalletEndpoints(t *testing.T) {
+	server, requests := networksServer(t)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	wallet, err := client.SupportedNetworks(context.Background(), "/v1/wallet/token_list")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(wallet, []string{"solana", "ethereum"}) {
+		t.Errorf("unexpected wallet networks: %v", wallet)
+	}
+
+	defi, err := client.SupportedNetworks(context.Background(), "/defi/v3/search")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(defi) != 4 {
+		t.Errorf("unexpected defi networks: %v", defi)
+	}
+
+	if requests["/v1/wallet/list_supported_chain"] != 1 || requests["/defi/networks"] != 1 {
+		t.Errorf("unexpected requests: %v", requests)
+	}
+
+	if _, err := client.SupportedNetworks(context.Background(), ""); err == nil {
+		t.Error("expected error for empty endpoint")
+	}
+}
+
+func TestSupportedNetworks_EndpointTable(t *testing.T) {
+	server, requests := networksServer(t)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	networks, err := client.SupportedNetworks(context.Background(), "/defi/v3/token/mint-burn-txs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(networks, []string{"solana"}) {
+		t.Errorf("unexpected mint/burn networks: %v", networks)
+	}
+	if len(requests) != 0 {
+		t.Errorf("expected no listing requests, got %v", requests)
+	}
+
+	err = client.CheckNetwork(context.Background(), "/defi/v3/token/mint-burn-txs", "ethereum")
+	if _, ok := IsUnsupportedNetworkError(err); !ok {
+		t.Errorf("expected UnsupportedNetworkError, got %v", err)
+	}
+
+	networks[0] = "mutated"
+	if again, _ := client.SupportedNetworks(context.Background(), "/defi/v3/token/mint-burn-txs"); again[0] != "solana" {
+		t.Error("expected the table not to alias returned slices")
+	}
+}
+
+func TestCheckNetwork(t *testing.T) {
+	server, _ := networksServer(t)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	if err := client.CheckNetwork(context.Background(), "/v1/wallet/token_list", "ethereum"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := client.CheckNetwork(context.Background(), "/v1/wallet/token_list", "base")
+	netErr, ok := IsUnsupportedNetworkError(err)
+	if !ok {
+		t.Fatalf("expected UnsupportedNetworkError, got %v", err)
+	}
+	if netErr.Endpoint != "/v1/wallet/token_list" || !slices.Equal(netErr.Supported, []string{"solana", "ethereum"}) {
+		t.Errorf("unexpected error: %+v", netErr)
+	}
+}
+
+func TestListNetworks_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/networks": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.ListNetworks(context.Background()); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
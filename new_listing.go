@@ -0,0 +1,314 @@
+package birdeye
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxNewListingLimit is the maximum number of new listings Birdeye returns per request.
+const MaxNewListingLimit = 20
+
+// MinNewListingPollInterval is the shortest interval a NewListingPoller
+// waits between polls.
+const MinNewListingPollInterval = time.Second
+
+// listingTimeLayout is the timestamp format of liquidityAddedAt.
+const listingTimeLayout = "2006-01-02T15:04:05"
+
+// NewListing is a token that recently had liquidity added for the first time.
+type NewListing struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Source is the DEX or launch platform the liquidity was added on.
+	Source string `json:"source"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logoURI"`
+
+	// Liquidity is the initial liquidity in USD.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// LiquidityAddedAt is the raw listing timestamp as returned by Birdeye.
+	LiquidityAddedAt string `json:"liquidityAddedAt"`
+
+	// ListedAt is LiquidityAddedAt parsed as UTC.
+	ListedAt time.Time `json:"-"`
+}
+
+// NewListingOptions configures new listing requests.
+//
+// A nil *NewListingOptions returns the most recent listings.
+type NewListingOptions struct {
+	// TimeTo returns listings up to this time. Zero means now.
+	TimeTo time.Time
+
+	// Limit is the number of listings to return (1-20). Zero uses the maximum.
+	Limit int
+
+	// MemePlatformEnabled includes tokens launched on meme platforms
+	// such as pump.fun.
+	MemePlatformEnabled bool
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *NewListingOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Limit < 0 || o.Limit > MaxNewListingLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 20", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a new listing request.
+func (o *NewListingOptions) params() url.Values {
+	params := url.Values{}
+	params.Set("meme_platform_enabled", "false")
+
+	limit := MaxNewListingLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if !o.TimeTo.IsZero() {
+		params.Set("time_to", strconv.FormatInt(o.TimeTo.Unix(), 10))
+	}
+	params.Set("meme_platform_enabled", strconv.FormatBool(o.MemePlatformEnabled))
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *NewListingOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// GetNewListings fetches the most recently listed tokens.
+//
+// Example:
+//
+//	listings, err := client.GetNewListings(ctx, &birdeye.NewListingOptions{
+//	    MemePlatformEnabled: true,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, listing := range listings {
+//	    log.Printf("%s listed on %s at %s", listing.Symbol, listing.Source, listing.ListedAt)
+//	}
+func (c *Client) GetNewListings(ctx context.Context, opts *NewListingOptions) ([]NewListing, error) {
+	const path = "/defi/v2/tokens/new_listing"
+
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseResponse[struct {
+		Items []NewListing `json:"items"`
+	}](body)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Items {
+		listedAt, err := parseListingTime(resp.Items[i].LiquidityAddedAt)
+		if err != nil {
+			return nil, fmt.Errorf("parse listing time for %s: %w", resp.Items[i].Address, err)
+		}
+		resp.Items[i].ListedAt = listedAt
+	}
+
+	c.logger.Debug("fetched new listings",
+		"count", len(resp.Items),
+	)
+
+	return resp.Items, nil
+}
+
+// parseListingTime parses a liquidityAddedAt timestamp, which Birdeye
+// returns without a zone designator, as UTC.
+func parseListingTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC(), nil
+	}
+	return time.ParseInLocation(listingTimeLayout, s, time.UTC)
+}
+
+// NewListingPoller repeatedly fetches new listings and reports only tokens
+// it has not seen before.
+//
+// The poller remembers the listing time of the newest token it has reported
+// and the addresses listed at that exact time, so overlapping responses
+// never produce duplicates. The first poll reports a single page of
+// listings; later polls page back until they reach listings already
+// reported, so bursts larger than the page size are not lost.
+//
+// A NewListingPoller is not safe for concurrent use.
+type NewListingPoller struct {
+	client   *Client
+	opts     NewListingOptions
+	interval time.Duration
+
+	// after waits for the next poll; replaced in tests with a fake clock.
+	after func(time.Duration) <-chan time.Time
+
+	lastSeen   time.Time
+	seenAtLast map[string]struct{}
+}
+
+// NewListingPoller creates a poller that fetches new listings every interval.
+//
+// Intervals shorter than MinNewListingPollInterval are raised to it. Only
+// opts.Limit and opts.MemePlatformEnabled are used; TimeTo is set from the
+// client's clock on every poll.
+func (c *Client) NewListingPoller(interval time.Duration, opts *NewListingOptions) *NewListingPoller {
+	return &NewListingPoller{
+		client:     c,
+		opts:       iteratorOptions(opts, MaxNewListingLimit),
+		interval:   max(interval, MinNewListingPollInterval),
+		after:      time.After,
+		seenAtLast: make(map[string]struct{}),
+	}
+}
+
+// Poll fetches listings since the previous poll and returns those not seen
+// before, oldest first.
+//
+// While a page is full and newer than the last reported listing, Poll
+// requests the next page back, ending at the oldest listing of the page.
+func (p *NewListingPoller) Poll(ctx context.Context) ([]NewListing, error) {
+	opts := p.opts
+	opts.TimeTo = p.client.now()
+
+	var listings []NewListing
+	fetched := make(map[string]struct{})
+	for {
+		page, err := p.client.GetNewListings(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		oldest := opts.TimeTo
+		for _, listing := range page {
+			if _, ok := fetched[listing.Address]; ok {
+				continue
+			}
+			fetched[listing.Address] = struct{}{}
+			listings = append(listings, listing)
+
+			if listing.ListedAt.Before(oldest) {
+				oldest = listing.ListedAt
+			}
+		}
+
+		if p.lastSeen.IsZero() || len(page) < opts.Limit || !oldest.After(p.lastSeen) {
+			break
+		}
+
+		// A full page sharing one second would request itself again.
+		if !oldest.Before(opts.TimeTo) {
+			oldest = opts.TimeTo.Add(-time.Second)
+		}
+		opts.TimeTo = oldest
+	}
+
+	sort.SliceStable(listings, func(i, j int) bool {
+		return listings[i].ListedAt.Before(listings[j].ListedAt)
+	})
+
+	var unseen []NewListing
+	for _, listing := range listings {
+		switch {
+		case listing.ListedAt.Before(p.lastSeen):
+			continue
+		case listing.ListedAt.Equal(p.lastSeen):
+			if _, ok := p.seenAtLast[listing.Address]; ok {
+				continue
+			}
+		default:
+			p.lastSeen = listing.ListedAt
+			clear(p.seenAtLast)
+		}
+
+		p.seenAtLast[listing.Address] = struct{}{}
+		unseen = append(unseen, listing)
+	}
+
+	return unseen, nil
+}
+
+// Run polls until ctx is canceled and sends each unseen listing on the
+// returned channel. The channel is closed when polling stops.
+//
+// Poll errors are logged and polling continues at the next interval.
+//
+// Example:
+//
+//	poller := client.NewListingPoller(10*time.Second, &birdeye.NewListingOptions{
+//	    MemePlatformEnabled: true,
+//	})
+//	for listing := range poller.Run(ctx) {
+//	    log.Printf("new token %s (%s)", listing.Symbol, listing.Address)
+//	}
+func (p *NewListingPoller) Run(ctx context.Context) <-chan NewListing {
+	out := make(chan NewListing)
+
+	go func() {
+		defer close(out)
+
+		for {
+			listings, err := p.Poll(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				p.client.logger.Warn("new listing poll failed", "error", err)
+			}
+
+			for _, listing := range listings {
+				select {
+				case out <- listing:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-p.after(p.interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
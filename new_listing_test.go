@@ -0,0 +1,346 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetNewListings_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v2/tokens/new_listing": wrapResponse(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{
+					"address":          "NewToken1",
+					"symbol":           "NEW",
+					"name":             "New Token",
+					"decimals":         6,
+					"source":           "raydium",
+					"liquidityAddedAt": "2024-09-18T17:42:38",
+					"logoURI":          "https://example.com/new.png",
+					"liquidity":        12345.678,
+				},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	listings, err := client.GetNewListings(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(listings) != 1 {
+		t.Fatalf("expected 1 listing, got %d", len(listings))
+	}
+
+	listing := listings[0]
+	if listing.Source != "raydium" {
+		t.Errorf("expected source 'raydium', got '%s'", listing.Source)
+	}
+	if !listing.ListedAt.Equal(time.Date(2024, 9, 18, 17, 42, 38, 0, time.UTC)) {
+		t.Errorf("unexpected listing time %s", listing.ListedAt)
+	}
+	if !listing.Liquidity.Equal(decimal.RequireFromString("12345.678")) {
+		t.Errorf("expected liquidity 12345.678, got %s", listing.Liquidity)
+	}
+}
+
+func TestGetNewListings_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": []}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetNewListings(context.Background(), &NewListingOptions{
+		TimeTo:              time.Unix(1726681733, 0),
+		Limit:               5,
+		MemePlatformEnabled: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"time_to":               "1726681733",
+		"limit":                 "5",
+		"meme_platform_enabled": "true",
+	}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestGetNewListings_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+	_, err := client.GetNewListings(context.Background(), &NewListingOptions{Limit: 21})
+
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetNewListings_BadTimestamp(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v2/tokens/new_listing": wrapResponse(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"address": "NewToken1", "liquidityAddedAt": "yesterday"},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetNewListings(context.Background(), nil); err == nil {
+		t.Error("expected error for unparseable listing time")
+	}
+}
+
+func TestGetNewListings_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v2/tokens/new_listing": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetNewListings(context.Background(), nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+// listingServer serves a sequence of new listing responses, repeating the
+// last one once exhausted, and records the time_to of every request.
+type listingServer struct {
+	mu      sync.Mutex
+	batches [][]map[string]interface{}
+	calls   int
+	timeTos []string
+}
+
+func (s *listingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.timeTos = append(s.timeTos, r.URL.Query().Get("time_to"))
+	batch := s.batches[min(s.calls, len(s.batches)-1)]
+	s.calls++
+
+	_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": batch}))
+}
+
+func listing(address, at string) map[string]interface{} {
+	return map[string]interface{}{"address": address, "liquidityAddedAt": at}
+}
+
+func TestNewListingPoller_Poll(t *testing.T) {
+	ls := &listingServer{batches: [][]map[string]interface{}{
+		{
+			listing("B", "2024-09-18T10:00:01"),
+			listing("A", "2024-09-18T10:00:00"),
+		},
+		{
+			// C shares B's timestamp, D is newer, A and B are repeats.
+			listing("D", "2024-09-18T10:00:02"),
+			listing("C", "2024-09-18T10:00:01"),
+			listing("B", "2024-09-18T10:00:01"),
+			listing("A", "2024-09-18T10:00:00"),
+		},
+	}}
+	server := httptest.NewServer(ls)
+	defer server.Close()
+
+	now := time.Unix(1726653600, 0)
+	client, _ := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithMaxRetries(0),
+		WithClock(func() time.Time { return now }),
+	)
+	poller := client.NewListingPoller(time.Second, nil)
+
+	first, err := poller.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first) != 2 || first[0].Address != "A" || first[1].Address != "B" {
+		t.Fatalf("expected [A B] oldest first, got %+v", first)
+	}
+
+	now = now.Add(time.Minute)
+	second, err := poller.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(second) != 2 || second[0].Address != "C" || second[1].Address != "D" {
+		t.Fatalf("expected [C D], got %+v", second)
+	}
+
+	third, err := poller.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(third) != 0 {
+		t.Errorf("expected no unseen listings, got %+v", third)
+	}
+
+	if ls.timeTos[0] != "1726653600" || ls.timeTos[1] != "1726653660" {
+		t.Errorf("expected time_to from client clock, got %v", ls.timeTos)
+	}
+}
+
+func TestNewListingPoller_Run(t *testing.T) {
+	ls := &listingServer{batches: [][]map[string]interface{}{
+		{listing("A", "2024-09-18T10:00:00")},
+		{listing("B", "2024-09-18T10:00:05"), listing("A", "2024-09-18T10:00:00")},
+	}}
+	server := httptest.NewServer(ls)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	poller := client.NewListingPoller(time.Hour, nil)
+
+	// Fake clock: each poll waits for an explicit tick instead of an hour.
+	ticks := make(chan time.Time)
+	poller.after = func(time.Duration) <-chan time.Time { return ticks }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := poller.Run(ctx)
+
+	if got := <-out; got.Address != "A" {
+		t.Fatalf("expected A, got %s", got.Address)
+	}
+	ticks <- time.Time{}
+	if got := <-out; got.Address != "B" {
+		t.Fatalf("expected B, got %s", got.Address)
+	}
+
+	cancel()
+	for range out {
+		t.Error("expected no further listings after cancellation")
+	}
+}
+
+// timedListingServer serves listings up to time_to (inclusive), newest
+// first, honoring limit.
+type timedListingServer struct {
+	mu       sync.Mutex
+	listings []map[string]interface{}
+	requests int
+}
+
+func (s *timedListingServer) add(address string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listings = append(s.listings, listing(address, at.UTC().Format(listingTimeLayout)))
+}
+
+func (s *timedListingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	timeTo, _ := strconv.ParseInt(r.URL.Query().Get("time_to"), 10, 64)
+
+	items := []map[string]interface{}{}
+	for i := len(s.listings) - 1; i >= 0 && len(items) < limit; i-- {
+		at, _ := parseListingTime(s.listings[i]["liquidityAddedAt"].(string))
+		if at.Unix() <= timeTo {
+			items = append(items, s.listings[i])
+		}
+	}
+	_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": items}))
+}
+
+func TestNewListingPoller_PagesBackThroughBurst(t *testing.T) {
+	base := time.Date(2024, 9, 18, 10, 0, 0, 0, time.UTC)
+	ls := &timedListingServer{}
+	ls.add("A", base)
+
+	server := httptest.NewServer(ls)
+	defer server.Close()
+
+	now := base.Add(time.Minute)
+	client, _ := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithMaxRetries(0),
+		WithClock(func() time.Time { return now }),
+	)
+	poller := client.NewListingPoller(time.Second, &NewListingOptions{Limit: 2})
+
+	first, err := poller.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first) != 1 || first[0].Address != "A" {
+		t.Fatalf("expected [A], got %+v", first)
+	}
+
+	// Seven listings land before the next poll, two of them sharing a second.
+	for i, addr := range []string{"B", "C", "D", "E", "F", "G"} {
+		ls.add(addr, base.Add(time.Duration(61+i)*time.Second))
+	}
+	ls.add("H", base.Add(66*time.Second))
+	now = base.Add(2 * time.Minute)
+	ls.requests = 0
+
+	second, err := poller.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, l := range second {
+		got = append(got, l.Address)
+	}
+	if len(got) != 7 {
+		t.Fatalf("expected all 7 new listings, got %v", got)
+	}
+	seen := map[string]bool{}
+	for i, l := range second {
+		if seen[l.Address] {
+			t.Errorf("duplicate listing %s", l.Address)
+		}
+		seen[l.Address] = true
+		if i > 0 && l.ListedAt.Before(second[i-1].ListedAt) {
+			t.Errorf("expected oldest first, got %v", got)
+		}
+	}
+	if ls.requests < 4 {
+		t.Errorf("expected the poll to page back, got %d requests", ls.requests)
+	}
+}
+
+func TestNewListingPoller_MinInterval(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	for _, interval := range []time.Duration{0, -time.Second, time.Millisecond} {
+		if p := client.NewListingPoller(interval, nil); p.interval != MinNewListingPollInterval {
+			t.Errorf("interval %s: expected %s, got %s", interval, MinNewListingPollInterval, p.interval)
+		}
+	}
+	if p := client.NewListingPoller(time.Minute, nil); p.interval != time.Minute {
+		t.Errorf("expected 1m interval, got %s", p.interval)
+	}
+}
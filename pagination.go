@@ -0,0 +1,74 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+)
+
+// pageFunc fetches a single page of results starting at offset.
+// It returns the page items and whether more pages are available.
+type pageFunc[T any] func(ctx context.Context, offset int) ([]T, bool, error)
+
+// paginate returns a lazy iterator over all items of an offset-paginated
+// endpoint.
+//
+// Pages are fetched on demand as the caller ranges over the iterator, so
+// breaking out of the loop stops further requests. The offset advances by
+// the number of items returned in each page. If maxItems is positive,
+// iteration stops after that many items have been yielded.
+//
+// A fetch error is yielded once as the final element.
+func paginate[T any](ctx context.Context, offset, maxItems int, fetch pageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		yielded := 0
+		for {
+			items, hasNext, err := fetch(ctx, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if maxItems > 0 && yielded >= maxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+			}
+
+			if !hasNext || len(items) == 0 {
+				return
+			}
+			if maxItems > 0 && yielded >= maxItems {
+				return
+			}
+			offset += len(items)
+		}
+	}
+}
+
+// pagedOptions is implemented by pointers to the options of paginated
+// endpoints.
+type pagedOptions[O any] interface {
+	*O
+
+	// pageLimit returns a pointer to the options' Limit field.
+	pageLimit() *int
+}
+
+// iteratorOptions returns a copy of possibly-nil options for an iterator,
+// with a zero Limit replaced by maxLimit so the iterator knows the page
+// size it requested.
+func iteratorOptions[O any, P pagedOptions[O]](opts P, maxLimit int) O {
+	var page O
+	if opts != nil {
+		page = *opts
+	}
+	if limit := P(&page).pageLimit(); *limit == 0 {
+		*limit = maxLimit
+	}
+	return page
+}
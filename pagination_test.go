@@ -0,0 +1,139 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// sliceFetcher returns a pageFunc that serves items in pages of size n.
+func sliceFetcher(items []int, n int, calls *int) pageFunc[int] {
+	return func(_ context.Context, offset int) ([]int, bool, error) {
+		*calls++
+		if offset >= len(items) {
+			return nil, false, nil
+		}
+		end := offset + n
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[offset:end], end < len(items), nil
+	}
+}
+
+func TestPaginate_AllItems(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	calls := 0
+
+	var got []int
+	for item, err := range paginate(context.Background(), 0, 0, sliceFetcher(items, 4, &calls)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, item)
+	}
+
+	if len(got) != len(items) {
+		t.Fatalf("expected %d items, got %d", len(items), len(got))
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 fetches, got %d", calls)
+	}
+}
+
+func TestPaginate_MaxItems(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	calls := 0
+
+	var got []int
+	for item, err := range paginate(context.Background(), 0, 5, sliceFetcher(items, 4, &calls)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, item)
+	}
+
+	if len(got) != 5 {
+		t.Fatalf("expected 5 items, got %d", len(got))
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 fetches, got %d", calls)
+	}
+}
+
+func TestPaginate_StartOffset(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5}
+	calls := 0
+
+	var got []int
+	for item, err := range paginate(context.Background(), 4, 0, sliceFetcher(items, 4, &calls)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, item)
+	}
+
+	if len(got) != 2 || got[0] != 4 {
+		t.Errorf("expected [4 5], got %v", got)
+	}
+}
+
+func TestPaginate_BreakStopsFetching(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	calls := 0
+
+	for item := range paginate(context.Background(), 0, 0, sliceFetcher(items, 2, &calls)) {
+		if item == 1 {
+			break
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("expected 1 fetch, got %d", calls)
+	}
+}
+
+func TestPaginate_Error(t *testing.T) {
+	fetchErr := errors.New("boom")
+	fetch := func(_ context.Context, offset int) ([]int, bool, error) {
+		if offset > 0 {
+			return nil, false, fetchErr
+		}
+		return []int{1, 2}, true, nil
+	}
+
+	var got []int
+	var gotErr error
+	for item, err := range paginate(context.Background(), 0, 0, fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, item)
+	}
+
+	if len(got) != 2 {
+		t.Errorf("expected 2 items before error, got %d", len(got))
+	}
+	if !errors.Is(gotErr, fetchErr) {
+		t.Errorf("expected fetch error, got %v", gotErr)
+	}
+}
+
+func TestIteratorOptions(t *testing.T) {
+	page := iteratorOptions[TradeListOptions](nil, MaxTradeLimit)
+	if page.Limit != MaxTradeLimit || page.Offset != 0 {
+		t.Errorf("expected defaults for nil options, got %+v", page)
+	}
+
+	opts := &TradeListOptions{Offset: 5, Limit: 10, MaxItems: 30}
+	page = iteratorOptions(opts, MaxTradeLimit)
+	if page.Limit != 10 || page.Offset != 5 || page.MaxItems != 30 {
+		t.Errorf("expected options to be copied, got %+v", page)
+	}
+
+	page.Offset = 25
+	if opts.Offset != 5 {
+		t.Error("expected the copy not to alias the options")
+	}
+}
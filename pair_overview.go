@@ -0,0 +1,231 @@
+package birdeye
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxPairOverviewBatch is the maximum number of addresses per multiple pair
+// overview request.
+const MaxPairOverviewBatch = 20
+
+// pairOverviewTimeframes are the windows reported by the pair overview endpoints.
+var pairOverviewTimeframes = []Timeframe{
+	Timeframe30m, Timeframe1h, Timeframe2h, Timeframe4h, Timeframe8h, Timeframe12h, Timeframe24h,
+}
+
+// PairStats contains a pool's trading activity over a single timeframe.
+//
+// Change percentages compare the window with the preceding window of the
+// same length.
+type PairStats struct {
+	// Trade is the total number of trades.
+	Trade int
+
+	// TradeChangePercent is the change in trade count.
+	TradeChangePercent decimal.Decimal
+
+	// UniqueWallet is the number of unique wallets that traded.
+	UniqueWallet int
+
+	// UniqueWalletChangePercent is the change in unique wallets.
+	UniqueWalletChangePercent decimal.Decimal
+
+	// Volume is the traded volume in USD.
+	Volume decimal.Decimal
+
+	// VolumeBase is the traded volume in base token units.
+	VolumeBase decimal.Decimal
+
+	// VolumeQuote is the traded volume in quote token units.
+	VolumeQuote decimal.Decimal
+
+	// VolumeChangePercent is the change in USD volume.
+	VolumeChangePercent decimal.Decimal
+}
+
+// PairOverview contains market data for a single liquidity pool.
+type PairOverview struct {
+	// Address is the pool address.
+	Address string
+
+	// Name is the pool's display name (e.g., "SOL-USDC").
+	Name string
+
+	// Source is the DEX the pool belongs to.
+	Source string
+
+	// Base is the pool's base token.
+	Base MarketToken
+
+	// Quote is the pool's quote token.
+	Quote MarketToken
+
+	// CreatedTime is when the pool was created, as returned by Birdeye.
+	CreatedTime string
+
+	// Price is the base token price in quote token units.
+	Price decimal.Decimal
+
+	// Liquidity is the pool's liquidity in USD.
+	Liquidity decimal.Decimal
+
+	// LiquidityChangePercent24h is the liquidity change over 24 hours.
+	LiquidityChangePercent24h decimal.Decimal
+
+	// Windows holds the trading statistics for each reported timeframe
+	// (30m, 1h, 2h, 4h, 8h, 12h and 24h).
+	Windows map[Timeframe]PairStats
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// Birdeye reports each statistic as a flat field per timeframe
+// (e.g., "volume_4h_quote"); these are grouped into Windows.
+func (p *PairOverview) UnmarshalJSON(data []byte) error {
+	f, err := newFlatFields(data)
+	if err != nil {
+		return err
+	}
+
+	*p = PairOverview{
+		Address:                   f.string("address"),
+		Name:                      f.string("name"),
+		Source:                    f.string("source"),
+		CreatedTime:               f.string("created_time"),
+		Price:                     f.decimal("price"),
+		Liquidity:                 f.decimal("liquidity"),
+		LiquidityChangePercent24h: f.decimal("liquidity_change_percentage_24h"),
+		Windows:                   make(map[Timeframe]PairStats, len(pairOverviewTimeframes)),
+	}
+	f.object("base", &p.Base)
+	f.object("quote", &p.Quote)
+
+	for _, tf := range pairOverviewTimeframes {
+		key := func(format string) string { return fmt.Sprintf(format, tf) }
+
+		if !f.has(key("trade_%s"), key("volume_%s"), key("unique_wallet_%s")) {
+			continue
+		}
+
+		p.Windows[tf] = PairStats{
+			Trade:                     f.int(key("trade_%s")),
+			TradeChangePercent:        f.decimal(key("trade_%s_change_percent")),
+			UniqueWallet:              f.int(key("unique_wallet_%s")),
+			UniqueWalletChangePercent: f.decimal(key("unique_wallet_%s_change_percent")),
+			Volume:                    f.decimal(key("volume_%s")),
+			VolumeBase:                f.decimal(key("volume_%s_base")),
+			VolumeQuote:               f.decimal(key("volume_%s_quote")),
+			VolumeChangePercent:       f.decimal(key("volume_%s_change_percent")),
+		}
+	}
+
+	return f.err
+}
+
+// GetPairOverview fetches market data for a single liquidity pool.
+//
+// Example:
+//
+//	pair, err := client.GetPairOverview(ctx, "58oQChx4yWmvKdwLLZzBi4ChoCc2fqCUWBkwMihLYQo2")
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("%s on %s: $%s liquidity, $%s 1h volume",
+//	    pair.Name, pair.Source, pair.Liquidity, pair.Windows[birdeye.Timeframe1h].Volume)
+func (c *Client) GetPairOverview(ctx context.Context, address string) (*PairOverview, error) {
+	const path = "/defi/v3/pair/overview/single"
+
+	if address == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "address is required",
+			Path:       path,
+		}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, err := parseResponse[PairOverview](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data for unknown pairs.
+	if pair.Address == "" {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "pair overview not found",
+			Path:       path,
+		}
+	}
+
+	c.logger.Debug("fetched pair overview",
+		"address", address,
+		"name", pair.Name,
+		"liquidity", pair.Liquidity.String(),
+	)
+
+	return pair, nil
+}
+
+// GetPairOverviews fetches market data for multiple liquidity pools.
+//
+// Birdeye supports up to 20 addresses per request. This method splits
+// larger lists into batches and fetches them concurrently.
+//
+// Returns a map of pool address -> overview. Unknown pools are omitted.
+func (c *Client) GetPairOverviews(ctx context.Context, addresses []string) (map[string]PairOverview, error) {
+	const path = "/defi/v3/pair/overview/multiple"
+
+	if len(addresses) == 0 {
+		return make(map[string]PairOverview), nil
+	}
+
+	for _, addr := range addresses {
+		if addr == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "address list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	result, err := fetchBatches(ctx, c, addresses, MaxPairOverviewBatch,
+		func(ctx context.Context, batch []string) (map[string]PairOverview, error) {
+			params := url.Values{}
+			params.Set("list_address", strings.Join(batch, ","))
+
+			body, err := c.doGet(ctx, path, params)
+			if err != nil {
+				return nil, err
+			}
+
+			pairs, err := parseResponse[map[string]*PairOverview](body)
+			if err != nil {
+				return nil, err
+			}
+			return derefNonNil(*pairs), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched multiple pair overviews",
+		"requested", len(addresses),
+		"received", len(result),
+	)
+
+	return result, nil
+}
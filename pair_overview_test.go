@@ -0,0 +1,201 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetPairOverview_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{
+			"address": "Pool123",
+			"name":    "SOL-USDC",
+			"source":  "Raydium",
+			"base": map[string]interface{}{
+				"address":  "So11111111111111111111111111111111111111112",
+				"symbol":   "SOL",
+				"decimals": 9,
+			},
+			"quote": map[string]interface{}{
+				"address":  "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+				"symbol":   "USDC",
+				"decimals": 6,
+			},
+			"created_time":                    "2021-08-08T13:51:32.000Z",
+			"price":                           152.123456,
+			"liquidity":                       "8765432.10",
+			"liquidity_change_percentage_24h": nil,
+			"trade_1h":                        1234,
+			"trade_1h_change_percent":         -5.5,
+			"unique_wallet_1h":                321,
+			"unique_wallet_1h_change_percent": 2.25,
+			"volume_1h":                       456789.12,
+			"volume_1h_base":                  3001.5,
+			"volume_1h_quote":                 456700,
+			"volume_1h_change_percent":        12.5,
+			"trade_24h":                       30000,
+			"volume_24h":                      10000000,
+		}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	pair, err := client.GetPairOverview(context.Background(), "Pool123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["address"][0] != "Pool123" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if pair.Name != "SOL-USDC" || pair.Source != "Raydium" {
+		t.Errorf("unexpected pair: %+v", pair)
+	}
+	if pair.Base.Symbol != "SOL" || pair.Base.Decimals != 9 || pair.Quote.Symbol != "USDC" {
+		t.Errorf("unexpected base/quote: %+v / %+v", pair.Base, pair.Quote)
+	}
+	if !pair.Liquidity.Equal(decimal.RequireFromString("8765432.10")) {
+		t.Errorf("expected liquidity 8765432.10, got %s", pair.Liquidity)
+	}
+	if !pair.LiquidityChangePercent24h.IsZero() {
+		t.Errorf("expected zero liquidity change for null, got %s", pair.LiquidityChangePercent24h)
+	}
+
+	h1, ok := pair.Windows[Timeframe1h]
+	if !ok {
+		t.Fatal("expected 1h window")
+	}
+	if h1.Trade != 1234 || h1.UniqueWallet != 321 {
+		t.Errorf("unexpected 1h counts: %+v", h1)
+	}
+	if !h1.VolumeBase.Equal(decimal.RequireFromString("3001.5")) {
+		t.Errorf("expected 1h base volume 3001.5, got %s", h1.VolumeBase)
+	}
+	if !h1.TradeChangePercent.Equal(decimal.RequireFromString("-5.5")) {
+		t.Errorf("expected 1h trade change -5.5, got %s", h1.TradeChangePercent)
+	}
+
+	if pair.Windows[Timeframe24h].Trade != 30000 {
+		t.Errorf("expected 24h trades 30000, got %d", pair.Windows[Timeframe24h].Trade)
+	}
+	if _, ok := pair.Windows[Timeframe4h]; ok {
+		t.Error("expected no 4h window when fields are absent")
+	}
+}
+
+func TestGetPairOverview_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	_, err := client.GetPairOverview(context.Background(), "")
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetPairOverview_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/pair/overview/single": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetPairOverview(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected not found APIError, got %v", err)
+	}
+}
+
+func TestGetPairOverview_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/pair/overview/single": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetPairOverview(context.Background(), "Pool123"); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetPairOverviews_Batches(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches []int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addrs := strings.Split(r.URL.Query().Get("list_address"), ",")
+		mu.Lock()
+		batches = append(batches, len(addrs))
+		mu.Unlock()
+
+		data := map[string]interface{}{}
+		for _, addr := range addrs {
+			if addr == "pool7" {
+				data[addr] = nil
+				continue
+			}
+			data[addr] = map[string]interface{}{"address": addr, "liquidity": 1000, "volume_30m": 5}
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(data))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	addresses := make([]string, 45)
+	for i := range addresses {
+		addresses[i] = "pool" + strconv.Itoa(i)
+	}
+
+	pairs, err := client.GetPairOverviews(context.Background(), addresses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pairs) != 44 {
+		t.Errorf("expected 44 pairs (null omitted), got %d", len(pairs))
+	}
+	if _, ok := pairs["pool7"]; ok {
+		t.Error("expected null pair to be omitted")
+	}
+	if !pairs["pool3"].Windows[Timeframe30m].Volume.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("expected 30m volume 5, got %s", pairs["pool3"].Windows[Timeframe30m].Volume)
+	}
+	if len(batches) != 3 {
+		t.Errorf("expected 3 batches, got %v", batches)
+	}
+	for _, n := range batches {
+		if n > MaxPairOverviewBatch {
+			t.Errorf("batch of %d exceeds limit", n)
+		}
+	}
+}
+
+func TestGetPairOverviews_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	if _, err := client.GetPairOverviews(context.Background(), []string{"a", ""}); err == nil {
+		t.Error("expected error for empty address")
+	}
+
+	pairs, err := client.GetPairOverviews(context.Background(), nil)
+	if err != nil || len(pairs) != 0 {
+		t.Errorf("expected empty result for empty list, got %v, %v", pairs, err)
+	}
+}
@@ -0,0 +1,164 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxPriceVolumeBatch is the maximum number of addresses per multi
+// price-volume request.
+const MaxPriceVolumeBatch = 50
+
+// priceVolumeTimeframes are the timeframes accepted by the price-volume endpoints.
+var priceVolumeTimeframes = []Timeframe{
+	Timeframe1h, Timeframe2h, Timeframe4h, Timeframe8h, Timeframe24h,
+}
+
+// PriceVolume contains a token's price and volume change over a timeframe.
+type PriceVolume struct {
+	// Price is the current price in USD.
+	Price decimal.Decimal `json:"price"`
+
+	// PriceChangePercent is the price change over the timeframe.
+	PriceChangePercent decimal.Decimal `json:"priceChangePercent"`
+
+	// VolumeUSD is the trading volume in USD over the timeframe.
+	VolumeUSD decimal.Decimal `json:"volumeUSD"`
+
+	// VolumeChangePercent is the change in volume vs the previous timeframe.
+	VolumeChangePercent decimal.Decimal `json:"volumeChangePercent"`
+
+	// UpdateUnixTime is when the data was last updated (Unix timestamp).
+	UpdateUnixTime int64 `json:"updateUnixTime"`
+
+	// UpdateHumanTime is a human-readable update timestamp.
+	UpdateHumanTime string `json:"updateHumanTime"`
+}
+
+// GetPriceVolume fetches price and volume change for a token over a timeframe.
+//
+// Supported timeframes are 1h, 2h, 4h, 8h and 24h.
+//
+// Example:
+//
+//	pv, err := client.GetPriceVolume(ctx, tokenAddress, birdeye.Timeframe1h)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("1h: price %s%%, volume %s%%", pv.PriceChangePercent, pv.VolumeChangePercent)
+func (c *Client) GetPriceVolume(ctx context.Context, address string, timeframe Timeframe) (*PriceVolume, error) {
+	const path = "/defi/price_volume/single"
+
+	if address == "" {
+		return nil, &APIError{StatusCode: 400, Message: "address is required", Path: path}
+	}
+	if !timeframe.oneOf(priceVolumeTimeframes...) {
+		return nil, &APIError{StatusCode: 400, Message: "unsupported timeframe: " + string(timeframe), Path: path}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+	params.Set("type", string(timeframe))
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := parseResponse[*PriceVolume](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data for unknown tokens.
+	pv := *data
+	if pv == nil {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "price volume not found",
+			Path:       path,
+		}
+	}
+
+	c.logger.Debug("fetched price volume",
+		"address", address,
+		"timeframe", timeframe,
+		"price", pv.Price.String(),
+		"volume_usd", pv.VolumeUSD.String(),
+	)
+
+	return pv, nil
+}
+
+// GetMultiplePriceVolumes fetches price and volume change for multiple
+// tokens over a timeframe.
+//
+// Birdeye supports up to 50 addresses per request. This method splits
+// larger lists into batches and fetches them concurrently.
+//
+// Returns a map of address -> price volume. Unknown tokens are omitted.
+//
+// Example:
+//
+//	pvs, err := client.GetMultiplePriceVolumes(ctx, addresses, birdeye.Timeframe4h)
+//	if err != nil {
+//	    return err
+//	}
+//	for addr, pv := range pvs {
+//	    if pv.VolumeChangePercent.GreaterThan(decimal.NewFromInt(200)) {
+//	        log.Printf("%s volume spiking", addr)
+//	    }
+//	}
+func (c *Client) GetMultiplePriceVolumes(ctx context.Context, addresses []string, timeframe Timeframe) (map[string]PriceVolume, error) {
+	const path = "/defi/price_volume/multi"
+
+	if len(addresses) == 0 {
+		return make(map[string]PriceVolume), nil
+	}
+
+	if !timeframe.oneOf(priceVolumeTimeframes...) {
+		return nil, &APIError{StatusCode: 400, Message: "unsupported timeframe: " + string(timeframe), Path: path}
+	}
+	for _, addr := range addresses {
+		if addr == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "address list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	result, err := fetchBatches(ctx, c, addresses, MaxPriceVolumeBatch,
+		func(ctx context.Context, batch []string) (map[string]PriceVolume, error) {
+			payload := map[string]string{
+				"list_address": strings.Join(batch, ","),
+				"type":         string(timeframe),
+			}
+
+			body, err := c.doPost(ctx, path, nil, payload)
+			if err != nil {
+				return nil, err
+			}
+
+			pvs, err := parseResponse[map[string]*PriceVolume](body)
+			if err != nil {
+				return nil, err
+			}
+			return derefNonNil(*pvs), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched multiple price volumes",
+		"timeframe", timeframe,
+		"requested", len(addresses),
+		"received", len(result),
+	)
+
+	return result, nil
+}
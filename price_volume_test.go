@@ -0,0 +1,184 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetPriceVolume_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{
+			"price":               0.0000234567,
+			"priceChangePercent":  -12.345,
+			"volumeUSD":           987654.321,
+			"volumeChangePercent": 250.5,
+			"updateUnixTime":      1726681733,
+			"updateHumanTime":     "2024-09-18T17:48:53",
+		}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	pv, err := client.GetPriceVolume(context.Background(), "Token", Timeframe4h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["address"][0] != "Token" || query["type"][0] != "4h" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if !pv.Price.Equal(decimal.RequireFromString("0.0000234567")) {
+		t.Errorf("expected price 0.0000234567, got %s", pv.Price)
+	}
+	if !pv.PriceChangePercent.Equal(decimal.RequireFromString("-12.345")) {
+		t.Errorf("expected price change -12.345, got %s", pv.PriceChangePercent)
+	}
+	if !pv.VolumeChangePercent.Equal(decimal.RequireFromString("250.5")) {
+		t.Errorf("expected volume change 250.5, got %s", pv.VolumeChangePercent)
+	}
+}
+
+func TestGetPriceVolume_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name      string
+		address   string
+		timeframe Timeframe
+	}{
+		{"empty address", "", Timeframe24h},
+		{"unsupported timeframe", "Token", Timeframe30m},
+		{"empty timeframe", "Token", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetPriceVolume(context.Background(), tt.address, tt.timeframe)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetPriceVolume_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/price_volume/single": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetPriceVolume(context.Background(), "Unknown", Timeframe1h)
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected not found APIError, got %v", err)
+	}
+}
+
+func TestGetPriceVolume_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/price_volume/single": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetPriceVolume(context.Background(), "Token", Timeframe24h); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetMultiplePriceVolumes_PostsBatches(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches []int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("expected JSON content type, got %q", ct)
+		}
+
+		var payload struct {
+			ListAddress string `json:"list_address"`
+			Type        string `json:"type"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		if payload.Type != "1h" {
+			t.Errorf("expected type 1h, got %q", payload.Type)
+		}
+
+		addrs := strings.Split(payload.ListAddress, ",")
+		mu.Lock()
+		batches = append(batches, len(addrs))
+		mu.Unlock()
+
+		data := map[string]interface{}{}
+		for _, addr := range addrs {
+			data[addr] = map[string]interface{}{"price": 1.5, "volumeUSD": 100}
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(data))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	addresses := make([]string, 120)
+	for i := range addresses {
+		addresses[i] = "token" + strconv.Itoa(i)
+	}
+
+	pvs, err := client.GetMultiplePriceVolumes(context.Background(), addresses, Timeframe1h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pvs) != 120 {
+		t.Errorf("expected 120 entries, got %d", len(pvs))
+	}
+	if !pvs["token42"].Price.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("expected price 1.5, got %s", pvs["token42"].Price)
+	}
+	if len(batches) != 3 {
+		t.Errorf("expected 3 batches, got %v", batches)
+	}
+	for _, n := range batches {
+		if n > MaxPriceVolumeBatch {
+			t.Errorf("batch of %d exceeds limit", n)
+		}
+	}
+}
+
+func TestGetMultiplePriceVolumes_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	if _, err := client.GetMultiplePriceVolumes(context.Background(), []string{"a", ""}, Timeframe1h); err == nil {
+		t.Error("expected error for empty address")
+	}
+	if _, err := client.GetMultiplePriceVolumes(context.Background(), []string{"a"}, Timeframe("1w")); err == nil {
+		t.Error("expected error for unsupported timeframe")
+	}
+
+	pvs, err := client.GetMultiplePriceVolumes(context.Background(), nil, Timeframe1h)
+	if err != nil || len(pvs) != 0 {
+		t.Errorf("expected empty result for empty list, got %v, %v", pvs, err)
+	}
+}
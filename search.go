@@ -0,0 +1,314 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxSearchLimit is the maximum number of results Birdeye returns per page.
+const MaxSearchLimit = 20
+
+// SearchTarget selects which kinds of results a search returns.
+type SearchTarget string
+
+// Supported search targets.
+const (
+	SearchTargetAll    SearchTarget = "all"
+	SearchTargetToken  SearchTarget = "token"
+	SearchTargetMarket SearchTarget = "market"
+)
+
+// SearchSortField is the field search results are sorted by.
+type SearchSortField string
+
+// Supported search sort fields.
+const (
+	SearchSortVolume24hUSD          SearchSortField = "volume_24h_usd"
+	SearchSortLiquidity             SearchSortField = "liquidity"
+	SearchSortMarketCap             SearchSortField = "marketcap"
+	SearchSortFDV                   SearchSortField = "fdv"
+	SearchSortPrice                 SearchSortField = "price"
+	SearchSortPriceChange24hPercent SearchSortField = "price_change_24h_percent"
+	SearchSortTrade24h              SearchSortField = "trade_24h"
+)
+
+// TokenSearchHit is a token matching a search.
+type TokenSearchHit struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Network is the chain the token lives on (e.g., "solana").
+	Network string `json:"network"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logo_uri"`
+
+	// Verified reports whether Birdeye has verified the token.
+	Verified bool `json:"verified"`
+
+	// Price is the current price in USD.
+	Price decimal.Decimal `json:"price"`
+
+	// PriceChange24hPercent is the price change over 24 hours.
+	PriceChange24hPercent decimal.Decimal `json:"price_change_24h_percent"`
+
+	// Liquidity is the total liquidity in USD.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// MarketCap is the market capitalization in USD.
+	MarketCap decimal.Decimal `json:"market_cap"`
+
+	// FDV is the fully diluted valuation in USD.
+	FDV decimal.Decimal `json:"fdv"`
+
+	// Volume24hUSD is the 24-hour trading volume in USD.
+	Volume24hUSD decimal.Decimal `json:"volume_24h_usd"`
+
+	// Trade24h is the number of trades in the last 24 hours.
+	Trade24h int `json:"trade_24h"`
+
+	// UniqueWallet24h is the number of unique wallets that traded in the
+	// last 24 hours.
+	UniqueWallet24h int `json:"unique_wallet_24h"`
+
+	// LastTradeUnixTime is the Unix timestamp of the last trade.
+	LastTradeUnixTime int64 `json:"last_trade_unix_time"`
+
+	// CreationTime is when the token was created, as returned by Birdeye.
+	CreationTime string `json:"creation_time"`
+}
+
+// MarketSearchHit is a market (liquidity pool) matching a search.
+type MarketSearchHit struct {
+	// Address is the pool address.
+	Address string `json:"address"`
+
+	// Name is the pool's display name (e.g., "BONK-SOL").
+	Name string `json:"name"`
+
+	// Network is the chain the pool lives on (e.g., "solana").
+	Network string `json:"network"`
+
+	// Source is the DEX the pool belongs to.
+	Source string `json:"source"`
+
+	// BaseMint is the base token's mint address.
+	BaseMint string `json:"base_mint"`
+
+	// QuoteMint is the quote token's mint address.
+	QuoteMint string `json:"quote_mint"`
+
+	// Liquidity is the pool's liquidity in USD.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// Volume24hUSD is the 24-hour trading volume in USD.
+	Volume24hUSD decimal.Decimal `json:"volume_24h_usd"`
+
+	// Trade24h is the number of trades in the last 24 hours.
+	Trade24h int `json:"trade_24h"`
+
+	// UniqueWallet24h is the number of unique wallets that traded in the
+	// last 24 hours.
+	UniqueWallet24h int `json:"unique_wallet_24h"`
+
+	// CreationTime is when the pool was created, as returned by Birdeye.
+	CreationTime string `json:"creation_time"`
+}
+
+// SearchResults is a single page of search results.
+type SearchResults struct {
+	// Tokens are the matching tokens.
+	Tokens []TokenSearchHit
+
+	// Markets are the matching markets.
+	Markets []MarketSearchHit
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// Birdeye groups results into typed sections; unknown section types are
+// ignored.
+func (r *SearchResults) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Items []struct {
+			Type   string          `json:"type"`
+			Result json.RawMessage `json:"result"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*r = SearchResults{}
+	for _, section := range raw.Items {
+		if len(section.Result) == 0 || string(section.Result) == "null" {
+			continue
+		}
+
+		switch section.Type {
+		case string(SearchTargetToken):
+			var hits []TokenSearchHit
+			if err := json.Unmarshal(section.Result, &hits); err != nil {
+				return fmt.Errorf("token results: %w", err)
+			}
+			r.Tokens = append(r.Tokens, hits...)
+		case string(SearchTargetMarket):
+			var hits []MarketSearchHit
+			if err := json.Unmarshal(section.Result, &hits); err != nil {
+				return fmt.Errorf("market results: %w", err)
+			}
+			r.Markets = append(r.Markets, hits...)
+		}
+	}
+	return nil
+}
+
+// SearchOptions configures search requests.
+//
+// A nil *SearchOptions searches tokens and markets on Solana, sorted by
+// 24h USD volume, descending.
+type SearchOptions struct {
+	// Chain restricts results to a chain. Empty uses "solana"; "all"
+	// searches every chain Birdeye supports. Other chains are checked
+	// against ListNetworks first and rejected with an
+	// *UnsupportedNetworkError if Birdeye does not support them.
+	Chain string
+
+	// Target selects tokens, markets or both. Empty uses SearchTargetAll.
+	Target SearchTarget
+
+	// SortBy is the field to sort by. Empty uses SearchSortVolume24hUSD.
+	SortBy SearchSortField
+
+	// SortType is the sort direction. Empty uses desc.
+	SortType SortType
+
+	// VerifiedOnly restricts token results to Birdeye-verified tokens.
+	VerifiedOnly bool
+
+	// Offset is the number of results to skip.
+	Offset int
+
+	// Limit is the page size (1-20). Zero uses the maximum.
+	Limit int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *SearchOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxSearchLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 20", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a search request.
+func (o *SearchOptions) params(keyword string) url.Values {
+	params := url.Values{}
+	params.Set("keyword", keyword)
+	params.Set("chain", chainSolana)
+	params.Set("target", string(SearchTargetAll))
+	params.Set("sort_by", string(SearchSortVolume24hUSD))
+	params.Set("sort_type", string(SortDesc))
+
+	limit := MaxSearchLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.Chain != "" {
+		params.Set("chain", o.Chain)
+	}
+	if o.Target != "" {
+		params.Set("target", string(o.Target))
+	}
+	if o.SortBy != "" {
+		params.Set("sort_by", string(o.SortBy))
+	}
+	if o.SortType != "" {
+		params.Set("sort_type", string(o.SortType))
+	}
+	if o.VerifiedOnly {
+		params.Set("verify_token", "true")
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return params
+}
+
+// Search finds tokens and markets matching a keyword, such as a symbol,
+// name or address.
+//
+// Results are paginated with Offset and Limit; a page with fewer results
+// than the limit is the last.
+//
+// Example:
+//
+//	results, err := client.Search(ctx, "BONK", &birdeye.SearchOptions{
+//	    Target:       birdeye.SearchTargetToken,
+//	    VerifiedOnly: true,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, token := range results.Tokens {
+//	    log.Printf("%s (%s): %s", token.Symbol, token.Name, token.Address)
+//	}
+func (c *Client) Search(ctx context.Context, keyword string, opts *SearchOptions) (*SearchResults, error) {
+	const path = "/defi/v3/search"
+
+	if keyword == "" {
+		return nil, &APIError{StatusCode: 400, Message: "keyword is required", Path: path}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.Chain != "" && opts.Chain != "all" && opts.Chain != chainSolana {
+		if err := c.CheckNetwork(ctx, path, opts.Chain); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(keyword))
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := parseResponse[SearchResults](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("searched tokens and markets",
+		"keyword", keyword,
+		"tokens", len(results.Tokens),
+		"markets", len(results.Markets),
+	)
+
+	return results, nil
+}
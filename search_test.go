@@ -0,0 +1,214 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSearch_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/search": wrapResponse(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{
+					"type": "token",
+					"result": []map[string]interface{}{
+						{
+							"address":        "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263",
+							"symbol":         "Bonk",
+							"name":           "Bonk",
+							"network":        "solana",
+							"decimals":       5,
+							"verified":       true,
+							"price":          0.0000234567,
+							"liquidity":      "12345678.9",
+							"volume_24h_usd": 98765432.1,
+							"trade_24h":      54321,
+						},
+					},
+				},
+				{
+					"type": "market",
+					"result": []map[string]interface{}{
+						{
+							"address":    "BonkPool",
+							"name":       "Bonk-SOL",
+							"source":     "Orca",
+							"base_mint":  "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263",
+							"quote_mint": "So11111111111111111111111111111111111111112",
+							"liquidity":  4567890,
+						},
+					},
+				},
+				{"type": "wallet", "result": []map[string]interface{}{{"address": "ignored"}}},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	results, err := client.Search(context.Background(), "BONK", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results.Tokens) != 1 || len(results.Markets) != 1 {
+		t.Fatalf("expected 1 token and 1 market, got %d and %d", len(results.Tokens), len(results.Markets))
+	}
+
+	token := results.Tokens[0]
+	if token.Symbol != "Bonk" || token.Decimals != 5 || !token.Verified {
+		t.Errorf("unexpected token hit: %+v", token)
+	}
+	if !token.Price.Equal(decimal.RequireFromString("0.0000234567")) {
+		t.Errorf("expected price 0.0000234567, got %s", token.Price)
+	}
+
+	market := results.Markets[0]
+	if market.Source != "Orca" || market.BaseMint != token.Address {
+		t.Errorf("unexpected market hit: %+v", market)
+	}
+	if !market.Liquidity.Equal(decimal.NewFromInt(4567890)) {
+		t.Errorf("expected liquidity 4567890, got %s", market.Liquidity)
+	}
+}
+
+func TestSearch_Params(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *SearchOptions
+		expected map[string]string
+		absent   []string
+	}{
+		{
+			name: "defaults",
+			opts: nil,
+			expected: map[string]string{
+				"keyword": "BONK", "chain": "solana", "target": "all",
+				"sort_by": "volume_24h_usd", "sort_type": "desc", "limit": "20",
+			},
+			absent: []string{"verify_token", "offset"},
+		},
+		{
+			name: "all options",
+			opts: &SearchOptions{
+				Chain:        "all",
+				Target:       SearchTargetToken,
+				SortBy:       SearchSortLiquidity,
+				SortType:     SortAsc,
+				VerifiedOnly: true,
+				Offset:       40,
+				Limit:        10,
+			},
+			expected: map[string]string{
+				"chain": "all", "target": "token", "sort_by": "liquidity",
+				"sort_type": "asc", "verify_token": "true", "offset": "40", "limit": "10",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query map[string][]string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = w.Write([]byte(`{"success": true, "data": {"items": []}}`))
+			}))
+			defer server.Close()
+
+			client := testClient(t, server.URL)
+			if _, err := client.Search(context.Background(), "BONK", tt.opts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for k, v := range tt.expected {
+				if got := query[k]; len(got) != 1 || got[0] != v {
+					t.Errorf("expected %s=%s, got %v", k, v, got)
+				}
+			}
+			for _, k := range tt.absent {
+				if _, ok := query[k]; ok {
+					t.Errorf("expected %s to be absent", k)
+				}
+			}
+		})
+	}
+}
+
+func TestSearch_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name    string
+		keyword string
+		opts    *SearchOptions
+	}{
+		{"empty keyword", "", nil},
+		{"negative offset", "BONK", &SearchOptions{Offset: -1}},
+		{"limit too large", "BONK", &SearchOptions{Limit: 21}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Search(context.Background(), tt.keyword, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSearch_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/search": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.Search(context.Background(), "BONK", nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestSearch_UnsupportedChain(t *testing.T) {
+	var searched bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/defi/networks":
+			_, _ = w.Write([]byte(`{"success": true, "data": ["solana", "ethereum", "base"]}`))
+		default:
+			searched = true
+			_, _ = w.Write([]byte(`{"success": true, "data": {"items": []}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	_, err := client.Search(context.Background(), "PEPE", &SearchOptions{Chain: "tron"})
+	netErr, ok := IsUnsupportedNetworkError(err)
+	if !ok {
+		t.Fatalf("expected UnsupportedNetworkError, got %v", err)
+	}
+	if netErr.Network != "tron" || netErr.Endpoint != "/defi/v3/search" {
+		t.Errorf("unexpected error: %+v", netErr)
+	}
+	if searched {
+		t.Error("expected search not to be called for unsupported chain")
+	}
+
+	if _, err := client.Search(context.Background(), "PEPE", &SearchOptions{Chain: "ethereum"}); err != nil {
+		t.Errorf("unexpected error for supported chain: %v", err)
+	}
+	if !searched {
+		t.Error("expected search to be called for supported chain")
+	}
+}
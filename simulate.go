@@ -0,0 +1,153 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// SimulatedBalanceChange is the predicted change in one token's balance
+// for the sender of a simulated transaction.
+type SimulatedBalanceChange struct {
+	// Index is the position of the change in Birdeye's response.
+	Index int `json:"index"`
+
+	// Address is the token address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logoURI"`
+
+	// Before is the raw balance before the transaction (not adjusted for
+	// decimals).
+	Before decimal.Decimal `json:"before"`
+
+	// After is the raw balance after the transaction (not adjusted for
+	// decimals).
+	After decimal.Decimal `json:"after"`
+}
+
+// Delta returns the predicted raw balance change. Negative values are
+// outflows.
+func (c SimulatedBalanceChange) Delta() decimal.Decimal {
+	return c.After.Sub(c.Before)
+}
+
+// UIDelta returns the predicted balance change adjusted for decimals.
+func (c SimulatedBalanceChange) UIDelta() decimal.Decimal {
+	return uiAmount(c.Delta(), c.Decimals)
+}
+
+// Simulation is the predicted outcome of a transaction.
+type Simulation struct {
+	// BalanceChanges are the predicted per-token balance changes.
+	BalanceChanges []SimulatedBalanceChange `json:"balanceChange"`
+
+	// GasUsed is the gas the transaction is predicted to consume.
+	GasUsed uint64 `json:"gasUsed"`
+}
+
+// SimulateTransaction asks Birdeye to simulate a transaction and predict
+// the sender's balance changes before it is submitted.
+//
+// chain selects the network to simulate on (e.g., "ethereum"); it is
+// checked with CheckNetwork before the request is sent, so an unsupported
+// chain fails with *UnsupportedNetworkError. data is the hex-encoded call
+// data and value the native amount sent, as Birdeye expects them; value
+// may be empty.
+//
+// If Birdeye cannot simulate the transaction (e.g., it would revert), a
+// *SimulationError is returned. HTTP failures are reported as *APIError
+// and network failures as transport errors, so callers can tell a bad
+// transaction from an unavailable service.
+//
+// Example:
+//
+//	sim, err := client.SimulateTransaction(ctx, "ethereum", from, router, calldata, "0")
+//	if simErr, ok := birdeye.IsSimulationError(err); ok {
+//	    return fmt.Errorf("swap would fail: %s", simErr.Message)
+//	}
+//	if err != nil {
+//	    return err
+//	}
+//	for _, change := range sim.BalanceChanges {
+//	    log.Printf("%s: %s", change.Symbol, change.UIDelta())
+//	}
+func (c *Client) SimulateTransaction(ctx context.Context, chain, from, to, data, value string) (*Simulation, error) {
+	const path = "/v1/wallet/simulate"
+
+	if chain == "" {
+		return nil, &APIError{StatusCode: 400, Message: "chain is required", Path: path}
+	}
+	if from == "" {
+		return nil, &APIError{StatusCode: 400, Message: "from address is required", Path: path}
+	}
+	if to == "" {
+		return nil, &APIError{StatusCode: 400, Message: "to address is required", Path: path}
+	}
+	if data == "" {
+		return nil, &APIError{StatusCode: 400, Message: "transaction data is required", Path: path}
+	}
+
+	if err := c.CheckNetwork(ctx, path, chain); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]string{
+		"from":  from,
+		"to":    to,
+		"data":  data,
+		"value": value,
+	}
+
+	body, err := c.doPostChain(ctx, chain, path, nil, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Simulation failures come back as success=false with a reason, which
+	// parseResponse would flatten into a plain error.
+	var resp struct {
+		Success bool            `json:"success"`
+		Message string          `json:"message"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+	if !resp.Success {
+		msg := resp.Message
+		if msg == "" {
+			msg = "birdeye api returned success=false"
+		}
+		return nil, &SimulationError{Message: msg, Path: path}
+	}
+
+	var sim Simulation
+	if len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, &sim); err != nil {
+			return nil, fmt.Errorf("unmarshal simulation: %w", err)
+		}
+	}
+
+	c.logger.Debug("simulated transaction",
+		"chain", chain,
+		"from", from,
+		"to", to,
+		"balance_changes", len(sim.BalanceChanges),
+		"gas_used", sim.GasUsed,
+	)
+
+	return &sim, nil
+}
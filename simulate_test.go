@@ -0,0 +1,176 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// simulateChains is the wallet network listing served to simulation tests.
+var simulateChains = wrapResponse([]string{"solana", "ethereum"})
+
+func TestSimulateTransaction_Success(t *testing.T) {
+	var (
+		payload map[string]string
+		chain   string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/wallet/list_supported_chain" {
+			_ = json.NewEncoder(w).Encode(simulateChains)
+			return
+		}
+		chain = r.Header.Get("x-chain")
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/wallet/simulate" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"balanceChange": [
+					{"index": 0, "before": "5000000000000000000", "after": "4000000000000000000", "address": "0x0000000000000000000000000000000000000000", "symbol": "ETH", "decimals": 18},
+					{"index": 1, "before": 0, "after": 3012345678, "address": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", "symbol": "USDC", "decimals": 6}
+				],
+				"gasUsed": 152340
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	sim, err := client.SimulateTransaction(context.Background(), "ethereum", "0xFrom", "0xRouter", "0xdeadbeef", "1000000000000000000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if chain != "ethereum" {
+		t.Errorf("expected x-chain ethereum, got %q", chain)
+	}
+
+	expected := map[string]string{"from": "0xFrom", "to": "0xRouter", "data": "0xdeadbeef", "value": "1000000000000000000"}
+	for k, v := range expected {
+		if payload[k] != v {
+			t.Errorf("expected %s=%s, got %q", k, v, payload[k])
+		}
+	}
+
+	if sim.GasUsed != 152340 {
+		t.Errorf("expected gas 152340, got %d", sim.GasUsed)
+	}
+	if len(sim.BalanceChanges) != 2 {
+		t.Fatalf("expected 2 balance changes, got %d", len(sim.BalanceChanges))
+	}
+	if got := sim.BalanceChanges[0].UIDelta().String(); got != "-1" {
+		t.Errorf("expected ETH delta -1, got %s", got)
+	}
+	if got := sim.BalanceChanges[1].UIDelta().String(); got != "3012.345678" {
+		t.Errorf("expected USDC delta 3012.345678, got %s", got)
+	}
+	if got := sim.BalanceChanges[1].Delta().String(); got != "3012345678" {
+		t.Errorf("expected raw USDC delta 3012345678, got %s", got)
+	}
+}
+
+func TestSimulateTransaction_SimulationError(t *testing.T) {
+	responses := map[string]interface{}{
+		"/v1/wallet/list_supported_chain": simulateChains,
+		"/v1/wallet/simulate": map[string]interface{}{
+			"success": false,
+			"message": "execution reverted: insufficient output amount",
+		},
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.SimulateTransaction(context.Background(), "ethereum", "0xFrom", "0xRouter", "0xdeadbeef", "")
+
+	simErr, ok := IsSimulationError(err)
+	if !ok {
+		t.Fatalf("expected SimulationError, got %v", err)
+	}
+	if simErr.Message != "execution reverted: insufficient output amount" {
+		t.Errorf("unexpected message: %q", simErr.Message)
+	}
+	if _, ok := IsAPIError(err); ok {
+		t.Error("simulation failure should not be an APIError")
+	}
+}
+
+func TestSimulateTransaction_HTTPErrorIsNotSimulationError(t *testing.T) {
+	responses := map[string]interface{}{
+		"/v1/wallet/list_supported_chain": simulateChains,
+		"/v1/wallet/simulate":             http.StatusForbidden,
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.SimulateTransaction(context.Background(), "ethereum", "0xFrom", "0xRouter", "0xdeadbeef", "")
+
+	if _, ok := IsSimulationError(err); ok {
+		t.Error("HTTP failure should not be a SimulationError")
+	}
+	if apiErr, ok := IsAPIError(err); !ok || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected 403 APIError, got %v", err)
+	}
+}
+
+func TestSimulateTransaction_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name                  string
+		chain, from, to, data string
+	}{
+		{"empty chain", "", "0xFrom", "0xTo", "0x00"},
+		{"empty from", "ethereum", "", "0xTo", "0x00"},
+		{"empty to", "ethereum", "0xFrom", "", "0x00"},
+		{"empty data", "ethereum", "0xFrom", "0xTo", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.SimulateTransaction(context.Background(), tt.chain, tt.from, tt.to, tt.data, "")
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSimulateTransaction_UnsupportedChain(t *testing.T) {
+	simulated := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/wallet/simulate" {
+			simulated = true
+		}
+		_ = json.NewEncoder(w).Encode(simulateChains)
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.SimulateTransaction(context.Background(), "arbitrum", "0xFrom", "0xRouter", "0xdeadbeef", "")
+
+	netErr, ok := IsUnsupportedNetworkError(err)
+	if !ok {
+		t.Fatalf("expected UnsupportedNetworkError, got %v", err)
+	}
+	if netErr.Network != "arbitrum" || netErr.Endpoint != "/v1/wallet/simulate" {
+		t.Errorf("unexpected error: %+v", netErr)
+	}
+	if simulated {
+		t.Error("expected no simulation request for an unsupported chain")
+	}
+}
@@ -48,13 +48,16 @@ func testServer(t *testing.T, responses map[string]interface{}) *httptest.Server
 }
 
 // testClient creates a test client pointing to the test server.
-func testClient(t *testing.T, serverURL string) *Client {
+// Additional options are applied after the test defaults.
+func testClient(t *testing.T, serverURL string, opts ...Option) *Client {
 	t.Helper()
 
-	client, err := NewClient("test-api-key",
+	opts = append([]Option{
 		WithBaseURL(serverURL),
 		WithMaxRetries(0), // Disable retries for tests
-	)
+	}, opts...)
+
+	client, err := NewClient("test-api-key", opts...)
 	if err != nil {
 		t.Fatalf("failed to create test client: %v", err)
 	}
@@ -0,0 +1,29 @@
+package birdeye
+
+// Timeframe is a trailing time window used by Birdeye's statistics endpoints.
+//
+// Not every endpoint accepts every timeframe; each method documents the
+// subset it supports.
+type Timeframe string
+
+// Supported timeframes.
+const (
+	Timeframe30m Timeframe = "30m"
+	Timeframe1h  Timeframe = "1h"
+	Timeframe2h  Timeframe = "2h"
+	Timeframe4h  Timeframe = "4h"
+	Timeframe6h  Timeframe = "6h"
+	Timeframe8h  Timeframe = "8h"
+	Timeframe12h Timeframe = "12h"
+	Timeframe24h Timeframe = "24h"
+)
+
+// oneOf reports whether tf is one of the allowed timeframes.
+func (tf Timeframe) oneOf(allowed ...Timeframe) bool {
+	for _, a := range allowed {
+		if tf == a {
+			return true
+		}
+	}
+	return false
+}
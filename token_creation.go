@@ -0,0 +1,112 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// TokenCreationInfo contains details about the transaction that created a token.
+//
+// This data is used to check:
+//   - Token age (very new tokens are higher risk)
+//   - Deployer wallet (known rug deployers)
+type TokenCreationInfo struct {
+	// TxHash is the signature of the token creation transaction.
+	TxHash string `json:"txHash"`
+
+	// Slot is the slot the creation transaction landed in.
+	Slot uint64 `json:"slot"`
+
+	// TokenAddress is the token's mint address.
+	TokenAddress string `json:"tokenAddress"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Owner is the wallet that created the token.
+	Owner string `json:"owner"`
+
+	// BlockUnixTime is the block time of the creation transaction (Unix timestamp).
+	BlockUnixTime int64 `json:"blockUnixTime"`
+
+	// BlockHumanTime is a human-readable creation timestamp.
+	BlockHumanTime string `json:"blockHumanTime"`
+
+	// BlockTime is the block time of the creation transaction in UTC.
+	BlockTime time.Time `json:"-"`
+
+	// now is the clock used by Age, inherited from the client.
+	now func() time.Time
+}
+
+// Age returns how long ago the token was created.
+//
+// The current time is read from the client's clock (see WithClock).
+func (tc *TokenCreationInfo) Age() time.Duration {
+	now := tc.now
+	if now == nil {
+		now = time.Now
+	}
+	return now().Sub(tc.BlockTime)
+}
+
+// GetTokenCreationInfo fetches creation details for a token.
+//
+// Returns an *APIError with status 404 if Birdeye has no creation record
+// for the token.
+//
+// Example:
+//
+//	info, err := client.GetTokenCreationInfo(ctx, tokenAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	if info.Age() < 24*time.Hour {
+//	    log.Warn("token is less than a day old", "creator", info.Owner)
+//	}
+func (c *Client) GetTokenCreationInfo(ctx context.Context, address string) (*TokenCreationInfo, error) {
+	const path = "/defi/token_creation_info"
+
+	if address == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "address is required",
+			Path:       path,
+		}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := parseResponse[TokenCreationInfo](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data for unknown tokens.
+	if info.TxHash == "" && info.BlockUnixTime == 0 {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "token creation info not found",
+			Path:       path,
+		}
+	}
+
+	info.BlockTime = time.Unix(info.BlockUnixTime, 0).UTC()
+	info.now = c.now
+
+	c.logger.Debug("fetched token creation info",
+		"address", address,
+		"owner", info.Owner,
+		"slot", info.Slot,
+		"block_time", info.BlockTime,
+	)
+
+	return info, nil
+}
@@ -0,0 +1,131 @@
+package birdeye
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetTokenCreationInfo_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/token_creation_info": wrapResponse(map[string]interface{}{
+			"txHash":         "CreationTx",
+			"slot":           223013867,
+			"tokenAddress":   "TokenMint123",
+			"decimals":       6,
+			"owner":          "CreatorAddr",
+			"blockUnixTime":  1697044029,
+			"blockHumanTime": "2023-10-11T17:07:09.000Z",
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	now := time.Unix(1697044029, 0).Add(36 * time.Hour)
+	client, err := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithMaxRetries(0),
+		WithClock(func() time.Time { return now }),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	info, err := client.GetTokenCreationInfo(context.Background(), "TokenMint123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info.TxHash != "CreationTx" {
+		t.Errorf("expected txHash 'CreationTx', got '%s'", info.TxHash)
+	}
+	if info.Slot != 223013867 {
+		t.Errorf("expected slot 223013867, got %d", info.Slot)
+	}
+	if info.Owner != "CreatorAddr" {
+		t.Errorf("expected owner 'CreatorAddr', got '%s'", info.Owner)
+	}
+	if info.Decimals != 6 {
+		t.Errorf("expected decimals 6, got %d", info.Decimals)
+	}
+	if !info.BlockTime.Equal(time.Date(2023, 10, 11, 17, 7, 9, 0, time.UTC)) {
+		t.Errorf("unexpected block time %s", info.BlockTime)
+	}
+	if info.Age() != 36*time.Hour {
+		t.Errorf("expected age 36h, got %s", info.Age())
+	}
+}
+
+func TestGetTokenCreationInfo_NullData(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/token_creation_info": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenCreationInfo(context.Background(), "UnknownToken")
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if !apiErr.IsNotFound() {
+		t.Errorf("expected IsNotFound to be true, got status %d", apiErr.StatusCode)
+	}
+}
+
+func TestGetTokenCreationInfo_EmptyAddress(t *testing.T) {
+	client, _ := NewClient("test-key")
+	_, err := client.GetTokenCreationInfo(context.Background(), "")
+
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.StatusCode != 400 {
+		t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+	}
+}
+
+func TestGetTokenCreationInfo_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/token_creation_info": 404,
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenCreationInfo(context.Background(), "UnknownToken")
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if !apiErr.IsNotFound() {
+		t.Error("expected IsNotFound to be true")
+	}
+}
+
+func TestGetTokenCreationInfo_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/token_creation_info": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenCreationInfo(context.Background(), "TokenMint123")
+	if err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestTokenCreationInfo_AgeWithoutClock(t *testing.T) {
+	info := &TokenCreationInfo{BlockTime: time.Now().Add(-time.Hour)}
+	if age := info.Age(); age < time.Hour || age > time.Hour+time.Minute {
+		t.Errorf("expected age of about 1h, got %s", age)
+	}
+}
@@ -0,0 +1,188 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTokenHolderLimit is the maximum number of holders Birdeye returns per page.
+const MaxTokenHolderLimit = 100
+
+// TokenHolder is a single token account holding a token.
+type TokenHolder struct {
+	// Owner is the wallet that owns the token account.
+	Owner string `json:"owner"`
+
+	// TokenAccount is the token account address.
+	TokenAccount string `json:"token_account"`
+
+	// Mint is the token's mint address.
+	Mint string `json:"mint"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Amount is the raw token balance (not adjusted for decimals).
+	Amount decimal.Decimal `json:"amount"`
+
+	// UIAmount is the balance adjusted for decimals.
+	UIAmount decimal.Decimal `json:"-"`
+}
+
+// TokenHolderOptions configures token holder requests.
+//
+// A nil *TokenHolderOptions returns the first page of the largest holders.
+type TokenHolderOptions struct {
+	// Offset is the number of holders to skip.
+	Offset int
+
+	// Limit is the page size (1-100). Zero uses the maximum.
+	Limit int
+
+	// MaxItems caps the number of holders yielded by AllTokenHolders.
+	// Zero means no cap. It is ignored by ListTokenHolders.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *TokenHolderOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxTokenHolderLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 100", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a token holders request.
+func (o *TokenHolderOptions) params(address string) url.Values {
+	params := url.Values{}
+	params.Set("address", address)
+
+	limit := MaxTokenHolderLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o != nil && o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *TokenHolderOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// ListTokenHolders fetches a page of a token's holders, largest first.
+//
+// Example:
+//
+//	holders, err := client.ListTokenHolders(ctx, tokenAddress, &birdeye.TokenHolderOptions{Limit: 20})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, h := range holders {
+//	    log.Printf("%s holds %s", h.Owner, h.UIAmount)
+//	}
+func (c *Client) ListTokenHolders(ctx context.Context, address string, opts *TokenHolderOptions) ([]TokenHolder, error) {
+	const path = "/defi/v3/token/holder"
+
+	if address == "" {
+		return nil, &APIError{StatusCode: 400, Message: "address is required", Path: path}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(address))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseResponse[struct {
+		Items []TokenHolder `json:"items"`
+	}](body)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Items {
+		h := &resp.Items[i]
+		h.UIAmount = uiAmount(h.Amount, h.Decimals)
+	}
+
+	c.logger.Debug("fetched token holders",
+		"address", address,
+		"count", len(resp.Items),
+	)
+
+	return resp.Items, nil
+}
+
+// AllTokenHolders returns a lazy iterator over a token's holders, largest
+// first, fetching further pages as the caller consumes them.
+//
+// Iteration ends when a page returns fewer holders than the page size.
+// Set opts.MaxItems to bound the total number of holders.
+//
+// Example:
+//
+//	for h, err := range client.AllTokenHolders(ctx, tokenAddress, &birdeye.TokenHolderOptions{MaxItems: 500}) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    owners = append(owners, h.Owner)
+//	}
+func (c *Client) AllTokenHolders(ctx context.Context, address string, opts *TokenHolderOptions) iter.Seq2[TokenHolder, error] {
+	page := iteratorOptions(opts, MaxTokenHolderLimit)
+
+	return paginate(ctx, page.Offset, page.MaxItems, func(ctx context.Context, offset int) ([]TokenHolder, bool, error) {
+		pageOpts := page
+		pageOpts.Offset = offset
+
+		holders, err := c.ListTokenHolders(ctx, address, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		return holders, len(holders) == page.Limit, nil
+	})
+}
+
+// TopHoldersPercent returns the percentage of supply held by the first n
+// holders (0-100).
+//
+// Holders are taken in the order given, which for ListTokenHolders is
+// largest first. The supply must be in UI units (adjusted for decimals),
+// such as TokenMarketData.TotalSupply. Returns zero if supply is not
+// positive.
+//
+// Example:
+//
+//	pct := birdeye.TopHoldersPercent(holders, 20, market.TotalSupply)
+//	if pct.GreaterThan(decimal.NewFromInt(50)) {
+//	    log.Warn("top 20 holders control more than half the supply")
+//	}
+func TopHoldersPercent(holders []TokenHolder, n int, supply decimal.Decimal) decimal.Decimal {
+	if !supply.IsPositive() {
+		return decimal.Zero
+	}
+
+	held := decimal.Zero
+	for i := 0; i < n && i < len(holders); i++ {
+		held = held.Add(holders[i].UIAmount)
+	}
+
+	return held.Div(supply).Mul(decimal.NewFromInt(100))
+}
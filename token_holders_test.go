@@ -0,0 +1,181 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestListTokenHolders_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/holder": wrapResponse(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{
+					"amount":        "123456789012345678",
+					"decimals":      9,
+					"mint":          "TokenMint123",
+					"owner":         "WhaleWallet",
+					"token_account": "WhaleATA",
+					"ui_amount":     123456789.01234568,
+				},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	holders, err := client.ListTokenHolders(context.Background(), "TokenMint123", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(holders) != 1 {
+		t.Fatalf("expected 1 holder, got %d", len(holders))
+	}
+
+	h := holders[0]
+	if h.Owner != "WhaleWallet" || h.TokenAccount != "WhaleATA" || h.Mint != "TokenMint123" {
+		t.Errorf("unexpected holder: %+v", h)
+	}
+	if h.Amount.String() != "123456789012345678" {
+		t.Errorf("expected raw amount 123456789012345678, got %s", h.Amount)
+	}
+	// Computed exactly, unlike the float ui_amount in the response.
+	if h.UIAmount.String() != "123456789.012345678" {
+		t.Errorf("expected ui amount 123456789.012345678, got %s", h.UIAmount)
+	}
+}
+
+func TestListTokenHolders_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": []}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListTokenHolders(context.Background(), "Token", &TokenHolderOptions{Offset: 200, Limit: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"address": "Token", "offset": "200", "limit": "50"}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestListTokenHolders_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name    string
+		address string
+		opts    *TokenHolderOptions
+	}{
+		{"empty address", "", nil},
+		{"negative offset", "Token", &TokenHolderOptions{Offset: -1}},
+		{"limit too large", "Token", &TokenHolderOptions{Limit: 101}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListTokenHolders(context.Background(), tt.address, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestListTokenHolders_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/holder": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.ListTokenHolders(context.Background(), "Token", nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllTokenHolders_MaxItems(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := []map[string]interface{}{}
+		for i := offset; i < offset+limit; i++ {
+			items = append(items, map[string]interface{}{"owner": "Wallet" + strconv.Itoa(i), "amount": "1"})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": items}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	count := 0
+	for h, err := range client.AllTokenHolders(context.Background(), "Token", &TokenHolderOptions{Limit: 10, MaxItems: 25}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if h.Owner != "Wallet"+strconv.Itoa(count) {
+			t.Errorf("expected Wallet%d, got %s", count, h.Owner)
+		}
+		count++
+	}
+
+	if count != 25 {
+		t.Errorf("expected 25 holders, got %d", count)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestTopHoldersPercent(t *testing.T) {
+	holders := []TokenHolder{
+		{UIAmount: decimal.NewFromInt(400)},
+		{UIAmount: decimal.NewFromInt(250)},
+		{UIAmount: decimal.NewFromInt(100)},
+	}
+	supply := decimal.NewFromInt(1000)
+
+	tests := []struct {
+		name     string
+		n        int
+		supply   decimal.Decimal
+		expected string
+	}{
+		{"top 1", 1, supply, "40"},
+		{"top 2", 2, supply, "65"},
+		{"n beyond list", 10, supply, "75"},
+		{"zero n", 0, supply, "0"},
+		{"zero supply", 2, decimal.Zero, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TopHoldersPercent(holders, tt.n, tt.supply)
+			if !got.Equal(decimal.RequireFromString(tt.expected)) {
+				t.Errorf("expected %s%%, got %s%%", tt.expected, got)
+			}
+		})
+	}
+}
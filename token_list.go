@@ -0,0 +1,222 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTokenListLimit is the maximum number of tokens Birdeye returns per page.
+const MaxTokenListLimit = 50
+
+// TokenListSortField is the field the token list is sorted by.
+type TokenListSortField string
+
+// Supported token list sort fields.
+const (
+	TokenListSortVolume24hUSD           TokenListSortField = "v24hUSD"
+	TokenListSortMarketCap              TokenListSortField = "mc"
+	TokenListSortVolume24hChangePercent TokenListSortField = "v24hChangePercent"
+	TokenListSortLiquidity              TokenListSortField = "liquidity"
+)
+
+// TokenListItem is a single token in the Birdeye token list.
+type TokenListItem struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logoURI"`
+
+	// Price is the current price in USD.
+	Price decimal.Decimal `json:"price"`
+
+	// Liquidity is the total liquidity in USD across all pools.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// MarketCap is the market capitalization in USD.
+	MarketCap decimal.Decimal `json:"mc"`
+
+	// Volume24hUSD is the 24-hour trading volume in USD.
+	Volume24hUSD decimal.Decimal `json:"v24hUSD"`
+
+	// Volume24hChangePercent is the change in volume vs previous 24h.
+	Volume24hChangePercent decimal.Decimal `json:"v24hChangePercent"`
+
+	// LastTradeUnixTime is the Unix timestamp of the last trade.
+	LastTradeUnixTime int64 `json:"lastTradeUnixTime"`
+}
+
+// TokenListPage is a single page of the token list.
+type TokenListPage struct {
+	// Tokens are the tokens in this page.
+	Tokens []TokenListItem `json:"tokens"`
+
+	// Total is the total number of tokens matching the query.
+	Total int `json:"total"`
+
+	// UpdateUnixTime is when the list was last updated (Unix timestamp).
+	UpdateUnixTime int64 `json:"updateUnixTime"`
+
+	// UpdateTime is a human-readable update timestamp.
+	UpdateTime string `json:"updateTime"`
+}
+
+// TokenListOptions configures token list requests.
+//
+// A nil *TokenListOptions uses the API defaults (sorted by 24h USD volume,
+// descending).
+type TokenListOptions struct {
+	// SortBy is the field to sort by. Empty uses TokenListSortVolume24hUSD.
+	SortBy TokenListSortField
+
+	// SortType is the sort direction. Empty uses desc.
+	SortType SortType
+
+	// MinLiquidity excludes tokens with less USD liquidity. Zero means no filter.
+	MinLiquidity decimal.Decimal
+
+	// Offset is the number of tokens to skip.
+	Offset int
+
+	// Limit is the page size (1-50). Zero uses the maximum.
+	Limit int
+
+	// MaxItems caps the number of tokens yielded by AllTokens.
+	// Zero means no cap. It is ignored by ListTokens.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *TokenListOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxTokenListLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 50", Path: path}
+	}
+	if o.MinLiquidity.IsNegative() {
+		return &APIError{StatusCode: 400, Message: "min liquidity must not be negative", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a token list request.
+func (o *TokenListOptions) params() url.Values {
+	params := url.Values{}
+	params.Set("sort_by", string(TokenListSortVolume24hUSD))
+	params.Set("sort_type", string(SortDesc))
+
+	limit := MaxTokenListLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.SortBy != "" {
+		params.Set("sort_by", string(o.SortBy))
+	}
+	if o.SortType != "" {
+		params.Set("sort_type", string(o.SortType))
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.MinLiquidity.IsPositive() {
+		params.Set("min_liquidity", o.MinLiquidity.String())
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *TokenListOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// ListTokens fetches a single page of the Birdeye token list.
+//
+// Example:
+//
+//	page, err := client.ListTokens(ctx, &birdeye.TokenListOptions{
+//	    SortBy:       birdeye.TokenListSortLiquidity,
+//	    MinLiquidity: decimal.NewFromInt(50000),
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, token := range page.Tokens {
+//	    log.Printf("%s: $%s liquidity", token.Symbol, token.Liquidity)
+//	}
+func (c *Client) ListTokens(ctx context.Context, opts *TokenListOptions) (*TokenListPage, error) {
+	const path = "/defi/tokenlist"
+
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := parseResponse[TokenListPage](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched token list",
+		"count", len(page.Tokens),
+		"total", page.Total,
+	)
+
+	return page, nil
+}
+
+// AllTokens returns a lazy iterator over every token in the Birdeye token
+// list matching the options.
+//
+// Pages are requested only as the caller consumes them. Set opts.MaxItems
+// to bound the total number of tokens.
+//
+// Example:
+//
+//	opts := &birdeye.TokenListOptions{MinLiquidity: decimal.NewFromInt(100000), MaxItems: 1000}
+//	for token, err := range client.AllTokens(ctx, opts) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    universe = append(universe, token.Address)
+//	}
+func (c *Client) AllTokens(ctx context.Context, opts *TokenListOptions) iter.Seq2[TokenListItem, error] {
+	page := iteratorOptions(opts, MaxTokenListLimit)
+
+	return paginate(ctx, page.Offset, page.MaxItems, func(ctx context.Context, offset int) ([]TokenListItem, bool, error) {
+		pageOpts := page
+		pageOpts.Offset = offset
+
+		tokens, err := c.ListTokens(ctx, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		return tokens.Tokens, offset+len(tokens.Tokens) < tokens.Total, nil
+	})
+}
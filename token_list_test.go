@@ -0,0 +1,198 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestListTokens_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/tokenlist": wrapResponse(map[string]interface{}{
+			"updateUnixTime": 1703980800,
+			"updateTime":     "2024-12-31T00:00:00",
+			"total":          2,
+			"tokens": []map[string]interface{}{
+				{
+					"address":           "Token1",
+					"symbol":            "ONE",
+					"name":              "Token One",
+					"decimals":          6,
+					"price":             0.000012345678,
+					"liquidity":         150000.25,
+					"mc":                12000000,
+					"v24hUSD":           987654.321,
+					"v24hChangePercent": -12.5,
+					"lastTradeUnixTime": 1703980700,
+				},
+				{
+					"address": "Token2",
+					"symbol":  "TWO",
+				},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	page, err := client.ListTokens(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if page.Total != 2 || len(page.Tokens) != 2 {
+		t.Fatalf("expected 2 tokens, got total=%d len=%d", page.Total, len(page.Tokens))
+	}
+
+	token := page.Tokens[0]
+	if token.Symbol != "ONE" || token.Decimals != 6 {
+		t.Errorf("unexpected token metadata: %+v", token)
+	}
+	if !token.Price.Equal(decimal.RequireFromString("0.000012345678")) {
+		t.Errorf("expected price 0.000012345678, got %s", token.Price)
+	}
+	if !token.Volume24hChangePercent.Equal(decimal.RequireFromString("-12.5")) {
+		t.Errorf("expected v24hChangePercent -12.5, got %s", token.Volume24hChangePercent)
+	}
+}
+
+func TestListTokens_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"tokens": [], "total": 0}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	tests := []struct {
+		name     string
+		opts     *TokenListOptions
+		expected map[string]string
+	}{
+		{
+			name:     "defaults",
+			opts:     nil,
+			expected: map[string]string{"sort_by": "v24hUSD", "sort_type": "desc", "limit": "50"},
+		},
+		{
+			name: "custom",
+			opts: &TokenListOptions{
+				SortBy:       TokenListSortMarketCap,
+				SortType:     SortAsc,
+				MinLiquidity: decimal.NewFromInt(50000),
+				Offset:       100,
+				Limit:        25,
+			},
+			expected: map[string]string{
+				"sort_by":       "mc",
+				"sort_type":     "asc",
+				"min_liquidity": "50000",
+				"offset":        "100",
+				"limit":         "25",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.ListTokens(context.Background(), tt.opts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for k, v := range tt.expected {
+				if got := query[k]; len(got) != 1 || got[0] != v {
+					t.Errorf("expected %s=%s, got %v", k, v, got)
+				}
+			}
+		})
+	}
+}
+
+func TestListTokens_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name string
+		opts *TokenListOptions
+	}{
+		{"negative offset", &TokenListOptions{Offset: -1}},
+		{"limit too large", &TokenListOptions{Limit: 51}},
+		{"negative min liquidity", &TokenListOptions{MinLiquidity: decimal.NewFromInt(-1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListTokens(context.Background(), tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != 400 {
+				t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestListTokens_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/tokenlist": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListTokens(context.Background(), nil)
+	if err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllTokens_Paginates(t *testing.T) {
+	const total = 5
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		tokens := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			tokens = append(tokens, map[string]interface{}{"address": "Token" + strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{
+			"tokens": tokens,
+			"total":  total,
+		}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	var addresses []string
+	for token, err := range client.AllTokens(context.Background(), &TokenListOptions{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		addresses = append(addresses, token.Address)
+	}
+
+	if len(addresses) != total {
+		t.Fatalf("expected %d tokens, got %d", total, len(addresses))
+	}
+	if addresses[4] != "Token4" {
+		t.Errorf("expected last token Token4, got %s", addresses[4])
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
@@ -0,0 +1,144 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTokenMarketDataBatch is the maximum number of addresses per multiple
+// market data request.
+const MaxTokenMarketDataBatch = 20
+
+// TokenMarketData contains price, supply and valuation data for a token.
+type TokenMarketData struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Price is the current price in USD.
+	Price decimal.Decimal `json:"price"`
+
+	// Liquidity is the total liquidity in USD across all pools.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// TotalSupply is the total token supply.
+	TotalSupply decimal.Decimal `json:"total_supply"`
+
+	// CirculatingSupply is the supply in circulation.
+	CirculatingSupply decimal.Decimal `json:"circulating_supply"`
+
+	// FDV is the fully diluted valuation in USD (price * total supply).
+	FDV decimal.Decimal `json:"fdv"`
+
+	// MarketCap is the circulating market capitalization in USD
+	// (price * circulating supply).
+	MarketCap decimal.Decimal `json:"market_cap"`
+
+	// Holder is the number of unique token holders.
+	Holder int `json:"holder"`
+}
+
+// GetTokenMarketData fetches market data for a single token.
+//
+// Example:
+//
+//	data, err := client.GetTokenMarketData(ctx, tokenAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("FDV $%s, circulating market cap $%s", data.FDV, data.MarketCap)
+func (c *Client) GetTokenMarketData(ctx context.Context, address string) (*TokenMarketData, error) {
+	const path = "/defi/v3/token/market-data"
+
+	if address == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "address is required",
+			Path:       path,
+		}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := parseResponse[TokenMarketData](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data for unknown tokens.
+	if data.Address == "" {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "token market data not found",
+			Path:       path,
+		}
+	}
+
+	c.logger.Debug("fetched token market data",
+		"address", address,
+		"price", data.Price.String(),
+		"fdv", data.FDV.String(),
+		"market_cap", data.MarketCap.String(),
+	)
+
+	return data, nil
+}
+
+// GetMultipleTokenMarketData fetches market data for multiple tokens.
+//
+// Birdeye supports up to 20 addresses per request. This method splits
+// larger lists into batches and fetches them concurrently.
+//
+// Returns a map of address -> market data. Unknown tokens are omitted.
+func (c *Client) GetMultipleTokenMarketData(ctx context.Context, addresses []string) (map[string]TokenMarketData, error) {
+	const path = "/defi/v3/token/market-data/multiple"
+
+	if len(addresses) == 0 {
+		return make(map[string]TokenMarketData), nil
+	}
+
+	for _, addr := range addresses {
+		if addr == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "address list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	result, err := fetchBatches(ctx, c, addresses, MaxTokenMarketDataBatch,
+		func(ctx context.Context, batch []string) (map[string]TokenMarketData, error) {
+			params := url.Values{}
+			params.Set("list_address", strings.Join(batch, ","))
+
+			body, err := c.doGet(ctx, path, params)
+			if err != nil {
+				return nil, err
+			}
+
+			data, err := parseResponse[map[string]*TokenMarketData](body)
+			if err != nil {
+				return nil, err
+			}
+			return derefNonNil(*data), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched multiple token market data",
+		"requested", len(addresses),
+		"received", len(result),
+	)
+
+	return result, nil
+}
@@ -0,0 +1,140 @@
+package birdeye
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetTokenMarketData_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/market-data": wrapResponse(map[string]interface{}{
+			"address":            "TokenMint123",
+			"price":              0.000123456789,
+			"liquidity":          250000.5,
+			"total_supply":       1000000000,
+			"circulating_supply": 600000000,
+			"fdv":                123456.789,
+			"market_cap":         74074.0734,
+			"holder":             4321,
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	data, err := client.GetTokenMarketData(context.Background(), "TokenMint123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !data.Price.Equal(decimal.RequireFromString("0.000123456789")) {
+		t.Errorf("expected price 0.000123456789, got %s", data.Price)
+	}
+	if !data.FDV.Equal(decimal.RequireFromString("123456.789")) {
+		t.Errorf("expected fdv 123456.789, got %s", data.FDV)
+	}
+	if !data.MarketCap.Equal(decimal.RequireFromString("74074.0734")) {
+		t.Errorf("expected market cap 74074.0734, got %s", data.MarketCap)
+	}
+	if !data.CirculatingSupply.Equal(decimal.NewFromInt(600000000)) {
+		t.Errorf("expected circulating supply 600000000, got %s", data.CirculatingSupply)
+	}
+	if data.Holder != 4321 {
+		t.Errorf("expected holder 4321, got %d", data.Holder)
+	}
+}
+
+func TestGetTokenMarketData_EmptyAddress(t *testing.T) {
+	client, _ := NewClient("test-key")
+	_, err := client.GetTokenMarketData(context.Background(), "")
+
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetTokenMarketData_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/market-data": 404,
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenMarketData(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected not found APIError, got %v", err)
+	}
+}
+
+func TestGetTokenMarketData_NullData(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/market-data": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenMarketData(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected not found APIError, got %v", err)
+	}
+}
+
+func TestGetTokenMarketData_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/market-data": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetTokenMarketData(context.Background(), "Token"); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetMultipleTokenMarketData_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/market-data/multiple": wrapResponse(map[string]interface{}{
+			"token1": map[string]interface{}{"address": "token1", "fdv": 1000},
+			"token2": nil,
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	data, err := client.GetMultipleTokenMarketData(context.Background(), []string{"token1", "token2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(data) != 1 {
+		t.Fatalf("expected 1 entry (null omitted), got %d", len(data))
+	}
+	if !data["token1"].FDV.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("expected fdv 1000, got %s", data["token1"].FDV)
+	}
+}
+
+func TestGetMultipleTokenMarketData_EmptyList(t *testing.T) {
+	client, _ := NewClient("test-key")
+	data, err := client.GetMultipleTokenMarketData(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) != 0 {
+		t.Errorf("expected empty map, got %d entries", len(data))
+	}
+}
@@ -0,0 +1,149 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// MaxTokenMetadataBatch is the maximum number of addresses per multiple
+// metadata request.
+const MaxTokenMetadataBatch = 50
+
+// TokenMetadata contains descriptive information about a token.
+//
+// This is a much cheaper way to get symbol, name, decimals and logo than
+// GetTokenOverview.
+type TokenMetadata struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol (e.g., "SOL").
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name (e.g., "Solana").
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logo_uri"`
+
+	// Extensions contains optional metadata links.
+	Extensions *TokenExtensions `json:"extensions,omitempty"`
+}
+
+// GetTokenMetadata fetches metadata for a single token.
+//
+// Example:
+//
+//	meta, err := client.GetTokenMetadata(ctx, "So11111111111111111111111111111111111111112")
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("%s (%s) has %d decimals", meta.Name, meta.Symbol, meta.Decimals)
+func (c *Client) GetTokenMetadata(ctx context.Context, address string) (*TokenMetadata, error) {
+	const path = "/defi/v3/token/meta-data/single"
+
+	if address == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "address is required",
+			Path:       path,
+		}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := parseResponse[TokenMetadata](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data for unknown tokens.
+	if meta.Address == "" {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "token metadata not found",
+			Path:       path,
+		}
+	}
+
+	c.logger.Debug("fetched token metadata",
+		"address", address,
+		"symbol", meta.Symbol,
+		"decimals", meta.Decimals,
+	)
+
+	return meta, nil
+}
+
+// GetMultipleTokenMetadata fetches metadata for multiple tokens.
+//
+// Birdeye supports up to 50 addresses per request. This method splits
+// larger lists into batches and fetches them concurrently (see
+// WithMaxConcurrency).
+//
+// Returns a map of address -> metadata. Unknown tokens are omitted from
+// the result.
+//
+// Example:
+//
+//	metas, err := client.GetMultipleTokenMetadata(ctx, addresses)
+//	if err != nil {
+//	    return err
+//	}
+//	for addr, meta := range metas {
+//	    log.Printf("%s: %s", addr, meta.Symbol)
+//	}
+func (c *Client) GetMultipleTokenMetadata(ctx context.Context, addresses []string) (map[string]TokenMetadata, error) {
+	const path = "/defi/v3/token/meta-data/multiple"
+
+	if len(addresses) == 0 {
+		return make(map[string]TokenMetadata), nil
+	}
+
+	for _, addr := range addresses {
+		if addr == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "address list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	result, err := fetchBatches(ctx, c, addresses, MaxTokenMetadataBatch,
+		func(ctx context.Context, batch []string) (map[string]TokenMetadata, error) {
+			params := url.Values{}
+			params.Set("list_address", strings.Join(batch, ","))
+
+			body, err := c.doGet(ctx, path, params)
+			if err != nil {
+				return nil, err
+			}
+
+			metas, err := parseResponse[map[string]*TokenMetadata](body)
+			if err != nil {
+				return nil, err
+			}
+			return derefNonNil(*metas), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched multiple token metadata",
+		"requested", len(addresses),
+		"received", len(result),
+	)
+
+	return result, nil
+}
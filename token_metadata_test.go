@@ -0,0 +1,227 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestGetTokenMetadata_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/meta-data/single": wrapResponse(map[string]interface{}{
+			"address":  "So11111111111111111111111111111111111111112",
+			"symbol":   "SOL",
+			"name":     "Wrapped SOL",
+			"decimals": 9,
+			"logo_uri": "https://example.com/sol.png",
+			"extensions": map[string]interface{}{
+				"coingecko_id": "solana",
+				"website":      "https://solana.com",
+				"twitter":      "https://twitter.com/solana",
+				"medium":       "https://medium.com/solana-labs",
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	meta, err := client.GetTokenMetadata(context.Background(), "So11111111111111111111111111111111111111112")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if meta.Symbol != "SOL" || meta.Decimals != 9 {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+	if meta.LogoURI != "https://example.com/sol.png" {
+		t.Errorf("expected logo URI, got '%s'", meta.LogoURI)
+	}
+	if meta.Extensions == nil {
+		t.Fatal("expected extensions to be present")
+	}
+	if meta.Extensions.Coingecko != "solana" {
+		t.Errorf("expected coingecko 'solana', got '%s'", meta.Extensions.Coingecko)
+	}
+	if meta.Extensions.Medium != "https://medium.com/solana-labs" {
+		t.Errorf("expected medium URL, got '%s'", meta.Extensions.Medium)
+	}
+}
+
+func TestGetTokenMetadata_EmptyAddress(t *testing.T) {
+	client, _ := NewClient("test-key")
+	_, err := client.GetTokenMetadata(context.Background(), "")
+
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetTokenMetadata_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/meta-data/single": 404,
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenMetadata(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected not found APIError, got %v", err)
+	}
+}
+
+func TestGetTokenMetadata_NullData(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/meta-data/single": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenMetadata(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected not found APIError, got %v", err)
+	}
+}
+
+func TestGetTokenMetadata_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/meta-data/single": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetTokenMetadata(context.Background(), "Token"); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetMultipleTokenMetadata_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/meta-data/multiple": wrapResponse(map[string]interface{}{
+			"token1": map[string]interface{}{"address": "token1", "symbol": "ONE", "decimals": 6},
+			"token2": map[string]interface{}{"address": "token2", "symbol": "TWO", "decimals": 9},
+			"token3": nil,
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	metas, err := client.GetMultipleTokenMetadata(context.Background(), []string{"token1", "token2", "token3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metas) != 2 {
+		t.Fatalf("expected 2 entries (null omitted), got %d", len(metas))
+	}
+	if metas["token2"].Symbol != "TWO" {
+		t.Errorf("expected token2 symbol 'TWO', got '%s'", metas["token2"].Symbol)
+	}
+}
+
+func TestGetMultipleTokenMetadata_EmptyList(t *testing.T) {
+	client, _ := NewClient("test-key")
+	metas, err := client.GetMultipleTokenMetadata(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(metas) != 0 {
+		t.Errorf("expected empty map, got %d entries", len(metas))
+	}
+}
+
+func TestGetMultipleTokenMetadata_EmptyAddress(t *testing.T) {
+	client, _ := NewClient("test-key")
+	_, err := client.GetMultipleTokenMetadata(context.Background(), []string{"token1", ""})
+
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetMultipleTokenMetadata_Batching(t *testing.T) {
+	var (
+		mu         sync.Mutex
+		batchSizes []int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		batch := strings.Split(r.URL.Query().Get("list_address"), ",")
+
+		mu.Lock()
+		batchSizes = append(batchSizes, len(batch))
+		mu.Unlock()
+
+		data := map[string]interface{}{}
+		for _, addr := range batch {
+			data[addr] = map[string]interface{}{"address": addr, "symbol": strings.ToUpper(addr)}
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(data))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	// 120 unique addresses plus duplicates.
+	var addresses []string
+	for i := 0; i < 120; i++ {
+		addresses = append(addresses, "token"+strconv.Itoa(i))
+	}
+	addresses = append(addresses, "token0", "token1")
+
+	metas, err := client.GetMultipleTokenMetadata(context.Background(), addresses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metas) != 120 {
+		t.Errorf("expected 120 entries, got %d", len(metas))
+	}
+	if metas["token119"].Symbol != "TOKEN119" {
+		t.Errorf("expected token119 symbol, got %+v", metas["token119"])
+	}
+
+	total := 0
+	for _, n := range batchSizes {
+		if n > MaxTokenMetadataBatch {
+			t.Errorf("batch of %d exceeds limit", n)
+		}
+		total += n
+	}
+	if len(batchSizes) != 3 || total != 120 {
+		t.Errorf("expected 3 batches covering 120 addresses, got %v", batchSizes)
+	}
+}
+
+func TestGetMultipleTokenMetadata_BatchError(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/meta-data/multiple": 403,
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetMultipleTokenMetadata(context.Background(), []string{"token1"})
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 403 {
+		t.Errorf("expected 403 APIError, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package birdeye
 
 import (
 	"context"
+	"encoding/json"
 	"net/url"
 
 	"github.com/shopspring/decimal"
@@ -104,10 +105,36 @@ type TokenExtensions struct {
 	// Discord is the project's Discord server URL.
 	Discord string `json:"discord,omitempty"`
 
+	// Medium is the project's Medium blog URL.
+	Medium string `json:"medium,omitempty"`
+
 	// Description is a brief description of the token.
 	Description string `json:"description,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// The v3 token endpoints name the CoinGecko ID "coingecko_id" rather than
+// "coingecko"; both are accepted.
+func (e *TokenExtensions) UnmarshalJSON(data []byte) error {
+	type extensions TokenExtensions
+	var raw struct {
+		extensions
+		CoingeckoID string `json:"coingecko_id"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*e = TokenExtensions(raw.extensions)
+	if e.Coingecko == "" {
+		e.Coingecko = raw.CoingeckoID
+	}
+
+	return nil
+}
+
 // GetTokenOverview fetches market overview data for a token.
 //
 // This endpoint provides data for token screening:
@@ -0,0 +1,227 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTokenTradeDataBatch is the maximum number of addresses per multiple
+// trade data request.
+const MaxTokenTradeDataBatch = 20
+
+// tradeDataTimeframes are the windows reported by the v3 trade data endpoints.
+var tradeDataTimeframes = []Timeframe{
+	Timeframe30m, Timeframe1h, Timeframe2h, Timeframe4h, Timeframe8h, Timeframe24h,
+}
+
+// TradeStats contains trading activity over a single timeframe.
+//
+// Change percentages compare the window with the preceding window of the
+// same length.
+type TradeStats struct {
+	// HistoryPrice is the USD price at the start of the window.
+	HistoryPrice decimal.Decimal
+
+	// PriceChangePercent is the price change over the window.
+	PriceChangePercent decimal.Decimal
+
+	// UniqueWallet is the number of unique wallets that traded.
+	UniqueWallet int
+
+	// UniqueWalletChangePercent is the change in unique wallets.
+	UniqueWalletChangePercent decimal.Decimal
+
+	// Trade is the total number of trades.
+	Trade int
+
+	// TradeChangePercent is the change in trade count.
+	TradeChangePercent decimal.Decimal
+
+	// Buy is the number of buy trades.
+	Buy int
+
+	// BuyChangePercent is the change in buy count.
+	BuyChangePercent decimal.Decimal
+
+	// Sell is the number of sell trades.
+	Sell int
+
+	// SellChangePercent is the change in sell count.
+	SellChangePercent decimal.Decimal
+
+	// Volume is the traded volume in token units.
+	Volume decimal.Decimal
+
+	// VolumeUSD is the traded volume in USD.
+	VolumeUSD decimal.Decimal
+
+	// VolumeChangePercent is the change in volume.
+	VolumeChangePercent decimal.Decimal
+
+	// VolumeBuy is the buy volume in token units.
+	VolumeBuy decimal.Decimal
+
+	// VolumeBuyUSD is the buy volume in USD.
+	VolumeBuyUSD decimal.Decimal
+
+	// VolumeSell is the sell volume in token units.
+	VolumeSell decimal.Decimal
+
+	// VolumeSellUSD is the sell volume in USD.
+	VolumeSellUSD decimal.Decimal
+}
+
+// TokenTradeData contains a token's trading activity across several
+// timeframes.
+type TokenTradeData struct {
+	// Address is the token's mint address.
+	Address string
+
+	// Price is the current price in USD.
+	Price decimal.Decimal
+
+	// Holder is the number of unique token holders.
+	Holder int
+
+	// Market is the number of markets (pools) trading the token.
+	Market int
+
+	// LastTradeUnixTime is the Unix timestamp of the last trade.
+	LastTradeUnixTime int64
+
+	// LastTradeHumanTime is a human-readable timestamp of the last trade.
+	LastTradeHumanTime string
+
+	// Windows holds the trading statistics for each reported timeframe
+	// (30m, 1h, 2h, 4h, 8h and 24h).
+	Windows map[Timeframe]TradeStats
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// Birdeye reports each statistic as a flat field per timeframe
+// (e.g., "volume_buy_4h_usd"); these are grouped into Windows.
+func (d *TokenTradeData) UnmarshalJSON(data []byte) error {
+	f, err := newFlatFields(data)
+	if err != nil {
+		return err
+	}
+
+	*d = TokenTradeData{
+		Address:            f.string("address"),
+		Price:              f.decimal("price"),
+		Holder:             f.int("holder"),
+		Market:             f.int("market"),
+		LastTradeUnixTime:  f.decimal("last_trade_unix_time").IntPart(),
+		LastTradeHumanTime: f.string("last_trade_human_time"),
+		Windows:            f.windows(tradeDataTimeframes),
+	}
+
+	return f.err
+}
+
+// GetTokenTradeData fetches multi-timeframe trading statistics for a token.
+//
+// Example:
+//
+//	data, err := client.GetTokenTradeData(ctx, tokenAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	stats := data.Windows[birdeye.Timeframe1h]
+//	log.Printf("1h: %d buys / %d sells, $%s volume", stats.Buy, stats.Sell, stats.VolumeUSD)
+func (c *Client) GetTokenTradeData(ctx context.Context, address string) (*TokenTradeData, error) {
+	const path = "/defi/v3/token/trade-data/single"
+
+	if address == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "address is required",
+			Path:       path,
+		}
+	}
+
+	params := url.Values{}
+	params.Set("address", address)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := parseResponse[TokenTradeData](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data for unknown tokens.
+	if data.Address == "" {
+		return nil, &APIError{
+			StatusCode: 404,
+			Message:    "token trade data not found",
+			Path:       path,
+		}
+	}
+
+	c.logger.Debug("fetched token trade data",
+		"address", address,
+		"windows", len(data.Windows),
+	)
+
+	return data, nil
+}
+
+// GetMultipleTokenTradeData fetches multi-timeframe trading statistics for
+// multiple tokens.
+//
+// Birdeye supports up to 20 addresses per request. This method splits
+// larger lists into batches and fetches them concurrently.
+//
+// Returns a map of address -> trade data. Unknown tokens are omitted.
+func (c *Client) GetMultipleTokenTradeData(ctx context.Context, addresses []string) (map[string]TokenTradeData, error) {
+	const path = "/defi/v3/token/trade-data/multiple"
+
+	if len(addresses) == 0 {
+		return make(map[string]TokenTradeData), nil
+	}
+
+	for _, addr := range addresses {
+		if addr == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "address list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	result, err := fetchBatches(ctx, c, addresses, MaxTokenTradeDataBatch,
+		func(ctx context.Context, batch []string) (map[string]TokenTradeData, error) {
+			params := url.Values{}
+			params.Set("list_address", strings.Join(batch, ","))
+
+			body, err := c.doGet(ctx, path, params)
+			if err != nil {
+				return nil, err
+			}
+
+			data, err := parseResponse[map[string]*TokenTradeData](body)
+			if err != nil {
+				return nil, err
+			}
+			return derefNonNil(*data), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched multiple token trade data",
+		"requested", len(addresses),
+		"received", len(result),
+	)
+
+	return result, nil
+}
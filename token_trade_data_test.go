@@ -0,0 +1,179 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetTokenTradeData_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/trade-data/single": wrapResponse(map[string]interface{}{
+			"address":                         "TokenMint123",
+			"holder":                          5000,
+			"market":                          12,
+			"last_trade_unix_time":            1726676178,
+			"last_trade_human_time":           "2024-09-18T16:16:18",
+			"price":                           1.2345678912,
+			"history_1h_price":                1.2,
+			"price_change_1h_percent":         2.88,
+			"unique_wallet_1h":                150,
+			"unique_wallet_1h_change_percent": -3.5,
+			"trade_1h":                        420,
+			"trade_1h_change_percent":         10.1,
+			"buy_1h":                          300,
+			"sell_1h":                         120,
+			"volume_1h":                       100000.5,
+			"volume_1h_usd":                   123456.789,
+			"volume_buy_1h":                   70000.25,
+			"volume_buy_1h_usd":               86419.75,
+			"volume_sell_1h":                  30000.25,
+			"volume_sell_1h_usd":              37037.039,
+			"trade_24h":                       9000,
+			"volume_24h_usd":                  2500000,
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	data, err := client.GetTokenTradeData(context.Background(), "TokenMint123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if data.Address != "TokenMint123" || data.Holder != 5000 || data.Market != 12 {
+		t.Errorf("unexpected top-level fields: %+v", data)
+	}
+	if data.LastTradeUnixTime != 1726676178 {
+		t.Errorf("expected last trade time 1726676178, got %d", data.LastTradeUnixTime)
+	}
+	if !data.Price.Equal(decimal.RequireFromString("1.2345678912")) {
+		t.Errorf("expected price 1.2345678912, got %s", data.Price)
+	}
+
+	if len(data.Windows) != 2 {
+		t.Fatalf("expected 2 windows (1h, 24h), got %d", len(data.Windows))
+	}
+
+	hour, ok := data.Windows[Timeframe1h]
+	if !ok {
+		t.Fatal("expected 1h window")
+	}
+	if hour.Trade != 420 || hour.Buy != 300 || hour.Sell != 120 || hour.UniqueWallet != 150 {
+		t.Errorf("unexpected 1h counts: %+v", hour)
+	}
+	if !hour.VolumeBuyUSD.Equal(decimal.RequireFromString("86419.75")) {
+		t.Errorf("expected 1h buy volume 86419.75, got %s", hour.VolumeBuyUSD)
+	}
+	if !hour.UniqueWalletChangePercent.Equal(decimal.RequireFromString("-3.5")) {
+		t.Errorf("expected 1h unique wallet change -3.5, got %s", hour.UniqueWalletChangePercent)
+	}
+
+	if data.Windows[Timeframe24h].Trade != 9000 {
+		t.Errorf("expected 24h trades 9000, got %d", data.Windows[Timeframe24h].Trade)
+	}
+	if _, ok := data.Windows[Timeframe4h]; ok {
+		t.Error("expected absent 4h window to be omitted")
+	}
+}
+
+func TestTokenTradeData_MalformedField(t *testing.T) {
+	var data TokenTradeData
+	err := json.Unmarshal([]byte(`{"address": "Token", "volume_1h_usd": "not-a-number"}`), &data)
+	if err == nil || !strings.Contains(err.Error(), "volume_1h_usd") {
+		t.Errorf("expected error naming volume_1h_usd, got %v", err)
+	}
+}
+
+func TestGetTokenTradeData_EmptyAddress(t *testing.T) {
+	client, _ := NewClient("test-key")
+	_, err := client.GetTokenTradeData(context.Background(), "")
+
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetTokenTradeData_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/trade-data/single": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTokenTradeData(context.Background(), "Unknown")
+	apiErr, ok := IsAPIError(err)
+	if !ok || !apiErr.IsNotFound() {
+		t.Errorf("expected not found APIError, got %v", err)
+	}
+}
+
+func TestGetTokenTradeData_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v3/token/trade-data/single": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetTokenTradeData(context.Background(), "Token"); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetMultipleTokenTradeData_Batching(t *testing.T) {
+	var batches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		batches++
+		data := map[string]interface{}{}
+		for _, addr := range strings.Split(r.URL.Query().Get("list_address"), ",") {
+			data[addr] = map[string]interface{}{"address": addr, "trade_30m": 7}
+		}
+		data["unknown"] = nil
+		_ = json.NewEncoder(w).Encode(wrapResponse(data))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL, WithMaxConcurrency(1))
+
+	addresses := make([]string, 45)
+	for i := range addresses {
+		addresses[i] = "token" + string(rune('A'+i))
+	}
+
+	result, err := client.GetMultipleTokenTradeData(context.Background(), addresses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 45 {
+		t.Errorf("expected 45 entries, got %d", len(result))
+	}
+	if batches != 3 {
+		t.Errorf("expected 3 batches, got %d", batches)
+	}
+	if result["tokenA"].Windows[Timeframe30m].Trade != 7 {
+		t.Errorf("expected 30m trades 7, got %+v", result["tokenA"])
+	}
+}
+
+func TestGetMultipleTokenTradeData_EmptyAddress(t *testing.T) {
+	client, _ := NewClient("test-key")
+	_, err := client.GetMultipleTokenTradeData(context.Background(), []string{""})
+
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTopTraderLimit is the maximum number of top traders Birdeye returns per page.
+const MaxTopTraderLimit = 10
+
+// TopTraderSortField is the field top traders are ranked by.
+type TopTraderSortField string
+
+// Supported top trader sort fields.
+const (
+	TopTraderSortVolume TopTraderSortField = "volume"
+	TopTraderSortTrade  TopTraderSortField = "trade"
+)
+
+// topTraderTimeframes are the timeframes accepted by the top traders endpoint.
+var topTraderTimeframes = []Timeframe{
+	Timeframe30m, Timeframe1h, Timeframe2h, Timeframe4h,
+	Timeframe6h, Timeframe8h, Timeframe12h, Timeframe24h,
+}
+
+// TopTrader is a wallet's trading activity in a token over a timeframe.
+type TopTrader struct {
+	// TokenAddress is the token's mint address.
+	TokenAddress string `json:"tokenAddress"`
+
+	// Owner is the trader's wallet address.
+	Owner string `json:"owner"`
+
+	// Tags are Birdeye labels for the wallet (e.g., "bot", "sniper").
+	Tags []string `json:"tags"`
+
+	// Type is the timeframe the statistics cover.
+	Type Timeframe `json:"type"`
+
+	// Volume is the total traded volume in USD.
+	Volume decimal.Decimal `json:"volume"`
+
+	// VolumeBuy is the USD volume of buy trades.
+	VolumeBuy decimal.Decimal `json:"volumeBuy"`
+
+	// VolumeSell is the USD volume of sell trades.
+	VolumeSell decimal.Decimal `json:"volumeSell"`
+
+	// Trade is the total number of trades.
+	Trade int `json:"trade"`
+
+	// TradeBuy is the number of buy trades.
+	TradeBuy int `json:"tradeBuy"`
+
+	// TradeSell is the number of sell trades.
+	TradeSell int `json:"tradeSell"`
+}
+
+// TopTraderOptions configures top trader requests.
+//
+// A nil *TopTraderOptions returns the first page in descending order.
+type TopTraderOptions struct {
+	// SortType is the sort direction. Empty uses desc.
+	SortType SortType
+
+	// Offset is the number of traders to skip.
+	Offset int
+
+	// Limit is the page size (1-10). Zero uses the maximum.
+	Limit int
+
+	// MaxItems caps the number of traders yielded by AllTopTraders.
+	// Zero means no cap. It is ignored by GetTopTraders.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *TopTraderOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxTopTraderLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 10", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a top traders request.
+func (o *TopTraderOptions) params(address string, timeframe Timeframe, sortBy TopTraderSortField) url.Values {
+	params := url.Values{}
+	params.Set("address", address)
+	params.Set("time_frame", string(timeframe))
+	params.Set("sort_by", string(sortBy))
+	params.Set("sort_type", string(SortDesc))
+
+	limit := MaxTopTraderLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.SortType != "" {
+		params.Set("sort_type", string(o.SortType))
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *TopTraderOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// GetTopTraders fetches a page of the wallets that traded a token the most
+// over a timeframe.
+//
+// Supported timeframes are 30m, 1h, 2h, 4h, 6h, 8h, 12h and 24h.
+//
+// Example:
+//
+//	traders, err := client.GetTopTraders(ctx, tokenAddress,
+//	    birdeye.Timeframe24h, birdeye.TopTraderSortVolume, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, trader := range traders {
+//	    log.Printf("%s bought $%s sold $%s", trader.Owner, trader.VolumeBuy, trader.VolumeSell)
+//	}
+func (c *Client) GetTopTraders(ctx context.Context, address string, timeframe Timeframe, sortBy TopTraderSortField, opts *TopTraderOptions) ([]TopTrader, error) {
+	const path = "/defi/v2/tokens/top_traders"
+
+	if address == "" {
+		return nil, &APIError{StatusCode: 400, Message: "address is required", Path: path}
+	}
+	if !timeframe.oneOf(topTraderTimeframes...) {
+		return nil, &APIError{StatusCode: 400, Message: "unsupported timeframe: " + string(timeframe), Path: path}
+	}
+	if sortBy != TopTraderSortVolume && sortBy != TopTraderSortTrade {
+		return nil, &APIError{StatusCode: 400, Message: "unsupported sort field: " + string(sortBy), Path: path}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(address, timeframe, sortBy))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseResponse[struct {
+		Items []TopTrader `json:"items"`
+	}](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched top traders",
+		"address", address,
+		"timeframe", timeframe,
+		"count", len(resp.Items),
+	)
+
+	return resp.Items, nil
+}
+
+// AllTopTraders returns a lazy iterator over the top traders of a token,
+// fetching further pages as the caller consumes them.
+//
+// Iteration ends when a page returns fewer traders than the page size.
+// Set opts.MaxItems to bound the total number of traders.
+func (c *Client) AllTopTraders(ctx context.Context, address string, timeframe Timeframe, sortBy TopTraderSortField, opts *TopTraderOptions) iter.Seq2[TopTrader, error] {
+	page := iteratorOptions(opts, MaxTopTraderLimit)
+
+	return paginate(ctx, page.Offset, page.MaxItems, func(ctx context.Context, offset int) ([]TopTrader, bool, error) {
+		pageOpts := page
+		pageOpts.Offset = offset
+
+		traders, err := c.GetTopTraders(ctx, address, timeframe, sortBy, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		return traders, len(traders) == page.Limit, nil
+	})
+}
@@ -0,0 +1,170 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetTopTraders_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v2/tokens/top_traders": wrapResponse(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{
+					"tokenAddress": "TokenMint123",
+					"owner":        "WhaleWallet",
+					"tags":         []string{"smart_money"},
+					"type":         "24h",
+					"volume":       1500000.25,
+					"trade":        42,
+					"tradeBuy":     30,
+					"tradeSell":    12,
+					"volumeBuy":    1000000.125,
+					"volumeSell":   500000.125,
+				},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	traders, err := client.GetTopTraders(context.Background(), "TokenMint123", Timeframe24h, TopTraderSortVolume, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(traders) != 1 {
+		t.Fatalf("expected 1 trader, got %d", len(traders))
+	}
+
+	trader := traders[0]
+	if trader.Owner != "WhaleWallet" || trader.Type != Timeframe24h {
+		t.Errorf("unexpected trader: %+v", trader)
+	}
+	if len(trader.Tags) != 1 || trader.Tags[0] != "smart_money" {
+		t.Errorf("expected tags [smart_money], got %v", trader.Tags)
+	}
+	if trader.Trade != 42 || trader.TradeBuy != 30 || trader.TradeSell != 12 {
+		t.Errorf("unexpected trade counts: %+v", trader)
+	}
+	if !trader.VolumeBuy.Equal(decimal.RequireFromString("1000000.125")) {
+		t.Errorf("expected volumeBuy 1000000.125, got %s", trader.VolumeBuy)
+	}
+}
+
+func TestGetTopTraders_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": []}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTopTraders(context.Background(), "TokenMint123", Timeframe30m, TopTraderSortTrade,
+		&TopTraderOptions{SortType: SortAsc, Offset: 10, Limit: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"address":    "TokenMint123",
+		"time_frame": "30m",
+		"sort_by":    "trade",
+		"sort_type":  "asc",
+		"offset":     "10",
+		"limit":      "5",
+	}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestGetTopTraders_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name      string
+		address   string
+		timeframe Timeframe
+		sortBy    TopTraderSortField
+		opts      *TopTraderOptions
+	}{
+		{"empty address", "", Timeframe24h, TopTraderSortVolume, nil},
+		{"bad timeframe", "Token", Timeframe("1w"), TopTraderSortVolume, nil},
+		{"bad sort", "Token", Timeframe24h, TopTraderSortField("pnl"), nil},
+		{"limit too large", "Token", Timeframe24h, TopTraderSortVolume, &TopTraderOptions{Limit: 11}},
+		{"negative offset", "Token", Timeframe24h, TopTraderSortVolume, &TopTraderOptions{Offset: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetTopTraders(context.Background(), tt.address, tt.timeframe, tt.sortBy, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != 400 {
+				t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestGetTopTraders_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/v2/tokens/top_traders": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTopTraders(context.Background(), "Token", Timeframe24h, TopTraderSortVolume, nil)
+	if err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllTopTraders_Paginates(t *testing.T) {
+	const total = 23
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, map[string]interface{}{"owner": "Wallet" + strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": items}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	var owners []string
+	for trader, err := range client.AllTopTraders(context.Background(), "Token", Timeframe24h, TopTraderSortVolume, nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		owners = append(owners, trader.Owner)
+	}
+
+	if len(owners) != total {
+		t.Fatalf("expected %d traders, got %d", total, len(owners))
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
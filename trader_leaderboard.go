@@ -0,0 +1,193 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTraderLeaderboardLimit is the maximum number of traders Birdeye
+// returns per leaderboard page.
+const MaxTraderLeaderboardLimit = 10
+
+// LeaderboardTimeframe is the period a trader leaderboard covers.
+type LeaderboardTimeframe string
+
+// Supported leaderboard timeframes.
+const (
+	LeaderboardYesterday LeaderboardTimeframe = "yesterday"
+	LeaderboardToday     LeaderboardTimeframe = "today"
+	LeaderboardWeek      LeaderboardTimeframe = "1W"
+)
+
+// LeaderboardSort selects the top or bottom of the leaderboard.
+type LeaderboardSort string
+
+// Supported leaderboard sorts.
+const (
+	LeaderboardGainers LeaderboardSort = "gainers"
+	LeaderboardLosers  LeaderboardSort = "losers"
+)
+
+// TraderRank is a wallet's position on the trader leaderboard.
+type TraderRank struct {
+	// Wallet is the trader's wallet address.
+	Wallet string `json:"address"`
+
+	// Network is the chain the wallet traded on.
+	Network string `json:"network"`
+
+	// PnL is the trader's profit and loss in USD over the timeframe.
+	PnL decimal.Decimal `json:"pnl"`
+
+	// Volume is the trader's volume in USD over the timeframe.
+	Volume decimal.Decimal `json:"volume"`
+
+	// TradeCount is the number of trades over the timeframe.
+	TradeCount int `json:"trade_count"`
+}
+
+// TraderLeaderboardOptions configures trader leaderboard requests.
+//
+// A nil *TraderLeaderboardOptions returns the first page.
+type TraderLeaderboardOptions struct {
+	// Offset is the number of traders to skip.
+	Offset int
+
+	// Limit is the page size (1-10). Zero uses the maximum.
+	Limit int
+
+	// MaxItems caps the number of traders yielded by AllTraderLeaderboard.
+	// Zero means no cap. It is ignored by GetTraderLeaderboard.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *TraderLeaderboardOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxTraderLeaderboardLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 10", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a trader leaderboard request.
+func (o *TraderLeaderboardOptions) params(timeframe LeaderboardTimeframe, direction SortType) url.Values {
+	params := url.Values{}
+	params.Set("type", string(timeframe))
+	params.Set("sort_by", "PnL")
+	params.Set("sort_type", string(direction))
+
+	limit := MaxTraderLeaderboardLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o != nil && o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *TraderLeaderboardOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// GetTraderLeaderboard fetches a page of the traders with the highest
+// (gainers) or lowest (losers) PnL over a timeframe.
+//
+// Example:
+//
+//	ranks, err := client.GetTraderLeaderboard(ctx, birdeye.LeaderboardWeek, birdeye.LeaderboardGainers, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	for i, r := range ranks {
+//	    log.Printf("#%d %s: $%s PnL over %d trades", i+1, r.Wallet, r.PnL, r.TradeCount)
+//	}
+func (c *Client) GetTraderLeaderboard(ctx context.Context, timeframe LeaderboardTimeframe, sortType LeaderboardSort, opts *TraderLeaderboardOptions) ([]TraderRank, error) {
+	const path = "/trader/gainers-losers"
+
+	switch timeframe {
+	case LeaderboardYesterday, LeaderboardToday, LeaderboardWeek:
+	default:
+		return nil, &APIError{StatusCode: 400, Message: "unsupported timeframe: " + string(timeframe), Path: path}
+	}
+
+	// Birdeye ranks by PnL; gainers are the top of the list, losers the
+	// bottom.
+	var direction SortType
+	switch sortType {
+	case LeaderboardGainers:
+		direction = SortDesc
+	case LeaderboardLosers:
+		direction = SortAsc
+	default:
+		return nil, &APIError{StatusCode: 400, Message: "unsupported sort type: " + string(sortType), Path: path}
+	}
+
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(timeframe, direction))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseResponse[struct {
+		Items []TraderRank `json:"items"`
+	}](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched trader leaderboard",
+		"timeframe", timeframe,
+		"sort", sortType,
+		"count", len(resp.Items),
+	)
+
+	return resp.Items, nil
+}
+
+// AllTraderLeaderboard returns a lazy iterator over the trader leaderboard,
+// fetching further pages as the caller consumes them.
+//
+// Iteration ends when a page returns fewer traders than the page size.
+// Set opts.MaxItems to bound the total number of traders.
+//
+// Example:
+//
+//	opts := &birdeye.TraderLeaderboardOptions{MaxItems: 50}
+//	for r, err := range client.AllTraderLeaderboard(ctx, birdeye.LeaderboardToday, birdeye.LeaderboardGainers, opts) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    watchlist = append(watchlist, r.Wallet)
+//	}
+func (c *Client) AllTraderLeaderboard(ctx context.Context, timeframe LeaderboardTimeframe, sortType LeaderboardSort, opts *TraderLeaderboardOptions) iter.Seq2[TraderRank, error] {
+	page := iteratorOptions(opts, MaxTraderLeaderboardLimit)
+
+	return paginate(ctx, page.Offset, page.MaxItems, func(ctx context.Context, offset int) ([]TraderRank, bool, error) {
+		pageOpts := page
+		pageOpts.Offset = offset
+
+		ranks, err := c.GetTraderLeaderboard(ctx, timeframe, sortType, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		return ranks, len(ranks) == page.Limit, nil
+	})
+}
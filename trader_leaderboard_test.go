@@ -0,0 +1,156 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetTraderLeaderboard_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"items": [
+					{"network": "solana", "address": "SmartMoney1", "pnl": 125430.55, "trade_count": 312, "volume": "4567890.12"},
+					{"network": "solana", "address": "SmartMoney2", "pnl": 98000, "trade_count": 45, "volume": 1200000}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	ranks, err := client.GetTraderLeaderboard(context.Background(), LeaderboardWeek, LeaderboardGainers, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"type": "1W", "sort_by": "PnL", "sort_type": "desc", "limit": "10"}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+
+	if len(ranks) != 2 {
+		t.Fatalf("expected 2 ranks, got %d", len(ranks))
+	}
+	if ranks[0].Wallet != "SmartMoney1" || ranks[0].TradeCount != 312 || ranks[0].Network != "solana" {
+		t.Errorf("unexpected rank: %+v", ranks[0])
+	}
+	if !ranks[0].PnL.Equal(decimal.RequireFromString("125430.55")) {
+		t.Errorf("expected pnl 125430.55, got %s", ranks[0].PnL)
+	}
+	if !ranks[0].Volume.Equal(decimal.RequireFromString("4567890.12")) {
+		t.Errorf("expected volume 4567890.12, got %s", ranks[0].Volume)
+	}
+}
+
+func TestGetTraderLeaderboard_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": []}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTraderLeaderboard(context.Background(), LeaderboardYesterday, LeaderboardLosers,
+		&TraderLeaderboardOptions{Offset: 20, Limit: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"type": "yesterday", "sort_type": "asc", "offset": "20", "limit": "5"}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestGetTraderLeaderboard_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name      string
+		timeframe LeaderboardTimeframe
+		sort      LeaderboardSort
+		opts      *TraderLeaderboardOptions
+	}{
+		{"unsupported timeframe", "1M", LeaderboardGainers, nil},
+		{"empty timeframe", "", LeaderboardGainers, nil},
+		{"unsupported sort", LeaderboardToday, "neutral", nil},
+		{"negative offset", LeaderboardToday, LeaderboardGainers, &TraderLeaderboardOptions{Offset: -1}},
+		{"limit too large", LeaderboardToday, LeaderboardGainers, &TraderLeaderboardOptions{Limit: 11}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetTraderLeaderboard(context.Background(), tt.timeframe, tt.sort, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetTraderLeaderboard_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/trader/gainers-losers": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetTraderLeaderboard(context.Background(), LeaderboardToday, LeaderboardGainers, nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllTraderLeaderboard_MaxItems(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := []map[string]interface{}{}
+		for i := offset; i < offset+limit; i++ {
+			items = append(items, map[string]interface{}{"address": "Trader" + strconv.Itoa(i), "pnl": 1000 - i})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"items": items}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	count := 0
+	for r, err := range client.AllTraderLeaderboard(context.Background(), LeaderboardToday, LeaderboardGainers,
+		&TraderLeaderboardOptions{MaxItems: 25}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if r.Wallet != "Trader"+strconv.Itoa(count) {
+			t.Errorf("expected Trader%d, got %s", count, r.Wallet)
+		}
+		count++
+	}
+
+	if count != 25 {
+		t.Errorf("expected 25 traders, got %d", count)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
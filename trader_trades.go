@@ -0,0 +1,48 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+)
+
+// ListTraderTrades fetches a single page of a wallet's trade history,
+// bounded by time.
+//
+// Trades use the same Trade model as token and pair histories, so they can
+// be merged and analyzed together. Use TraderTradesInWindow to walk a
+// whole time range.
+//
+// Example:
+//
+//	page, err := client.ListTraderTrades(ctx, walletAddress, &birdeye.TradeSeekOptions{
+//	    AfterTime: time.Now().Add(-time.Hour),
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, trade := range page.Items {
+//	    log.Printf("%s %s in %s", trade.Side, trade.TxHash, trade.Pool())
+//	}
+func (c *Client) ListTraderTrades(ctx context.Context, wallet string, opts *TradeSeekOptions) (*TradePage, error) {
+	return c.listTradesByTime(ctx, "/trader/txs/seek_by_time", wallet, opts)
+}
+
+// TraderTradesInWindow returns a lazy iterator over every trade made by a
+// wallet within the window.
+//
+// See TokenTradesInWindow for cursor and de-duplication semantics.
+//
+// Example:
+//
+//	window := birdeye.TradeWindow{Start: time.Now().Add(-24 * time.Hour), Direction: birdeye.SeekForward}
+//	for trade, err := range client.TraderTradesInWindow(ctx, walletAddress, window) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    replay(trade)
+//	}
+func (c *Client) TraderTradesInWindow(ctx context.Context, wallet string, window TradeWindow) iter.Seq2[Trade, error] {
+	return seekTrades(ctx, window, func(ctx context.Context, opts *TradeSeekOptions) (*TradePage, error) {
+		return c.ListTraderTrades(ctx, wallet, opts)
+	})
+}
@@ -0,0 +1,102 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListTraderTrades_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/trader/txs/seek_by_time" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"items": [
+					{
+						"txHash": "TraderTx",
+						"source": "raydium",
+						"blockUnixTime": 1726681733,
+						"txType": "swap",
+						"owner": "SmartMoney",
+						"side": "buy",
+						"address": "Pool123",
+						"quote": {"symbol": "SOL", "address": "So11111111111111111111111111111111111111112", "uiAmount": 2.5, "decimals": 9},
+						"base": {"symbol": "BONK", "address": "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263", "uiAmount": 10000000, "decimals": 5}
+					}
+				],
+				"hasNext": true
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	page, err := client.ListTraderTrades(context.Background(), "SmartMoney", &TradeSeekOptions{
+		BeforeTime: time.Unix(2000, 0),
+		AfterTime:  time.Unix(1000, 0),
+		Limit:      20,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"address": "SmartMoney", "before_time": "2000", "after_time": "1000", "limit": "20"}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+
+	if !page.HasNext || len(page.Items) != 1 {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+	trade := page.Items[0]
+	if trade.TxHash != "TraderTx" || trade.Owner != "SmartMoney" || trade.Side != TradeSideBuy || trade.Pool() != "Pool123" {
+		t.Errorf("unexpected trade: %+v", trade)
+	}
+	if trade.Base == nil || trade.Base.Symbol != "BONK" {
+		t.Errorf("unexpected base leg: %+v", trade.Base)
+	}
+}
+
+func TestListTraderTrades_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	_, err := client.ListTraderTrades(context.Background(), "", nil)
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestTraderTradesInWindow_Backward(t *testing.T) {
+	server, _ := seekServer(t, boundaryHeavyTimes)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	window := TradeWindow{
+		Start: time.Unix(101, 0),
+		End:   time.Unix(106, 0),
+		Limit: 3,
+	}
+	trades := collectTrades(t, client.TraderTradesInWindow(context.Background(), "SmartMoney", window))
+
+	if len(trades) != 11 {
+		t.Fatalf("expected 11 trades, got %d", len(trades))
+	}
+
+	seen := map[string]bool{}
+	for _, trade := range trades {
+		if seen[trade.TxHash] {
+			t.Errorf("duplicate trade %s", trade.TxHash)
+		}
+		seen[trade.TxHash] = true
+	}
+}
@@ -0,0 +1,298 @@
+package birdeye
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTradeLimit is the maximum number of trades Birdeye returns per page.
+const MaxTradeLimit = 50
+
+// TradeType filters trade history by transaction type.
+type TradeType string
+
+// Supported trade types.
+const (
+	TradeTypeSwap   TradeType = "swap"
+	TradeTypeAdd    TradeType = "add"
+	TradeTypeRemove TradeType = "remove"
+	TradeTypeAll    TradeType = "all"
+)
+
+// SortType is the sort direction for list endpoints.
+type SortType string
+
+// Supported sort directions.
+const (
+	SortDesc SortType = "desc"
+	SortAsc  SortType = "asc"
+)
+
+// TradeSide is the direction of a trade relative to the queried token.
+type TradeSide string
+
+// Trade sides reported by Birdeye.
+const (
+	TradeSideBuy  TradeSide = "buy"
+	TradeSideSell TradeSide = "sell"
+)
+
+// Trade is a single on-chain trade as reported by Birdeye.
+//
+// The same model is returned by token, pair and trader trade history
+// endpoints. Not every endpoint populates every field: token trades carry
+// Base/Quote legs and a PoolID, while pair trades carry From/To legs and
+// the pair in Address. Use Pool to get the pool identifier regardless of
+// the source endpoint.
+type Trade struct {
+	// TxHash is the transaction signature.
+	TxHash string `json:"txHash"`
+
+	// Source is the DEX or program that executed the trade (e.g., "raydium").
+	Source string `json:"source"`
+
+	// BlockUnixTime is the block time of the trade (Unix timestamp).
+	BlockUnixTime int64 `json:"blockUnixTime"`
+
+	// TxType is the transaction type (e.g., "swap", "add", "remove").
+	TxType string `json:"txType"`
+
+	// Owner is the wallet that signed the trade.
+	Owner string `json:"owner"`
+
+	// Side is the trade direction relative to the queried token, if reported.
+	Side TradeSide `json:"side,omitempty"`
+
+	// Address is the pair (pool) address for pair trade history.
+	Address string `json:"address,omitempty"`
+
+	// PoolID is the pool address for token trade history.
+	PoolID string `json:"poolId,omitempty"`
+
+	// Base is the base token leg of the trade, if reported.
+	Base *TradeLeg `json:"base,omitempty"`
+
+	// Quote is the quote token leg of the trade, if reported.
+	Quote *TradeLeg `json:"quote,omitempty"`
+
+	// From is the token leg sent by the owner, if reported.
+	From *TradeLeg `json:"from,omitempty"`
+
+	// To is the token leg received by the owner, if reported.
+	To *TradeLeg `json:"to,omitempty"`
+
+	// BasePrice is the USD price of the base token at trade time.
+	BasePrice decimal.Decimal `json:"basePrice"`
+
+	// QuotePrice is the USD price of the quote token at trade time.
+	QuotePrice decimal.Decimal `json:"quotePrice"`
+
+	// TokenPrice is the USD price of the queried token at trade time.
+	TokenPrice decimal.Decimal `json:"tokenPrice"`
+}
+
+// TradeLeg is one token side of a trade.
+type TradeLeg struct {
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Amount is the raw token amount (not adjusted for decimals).
+	Amount decimal.Decimal `json:"amount"`
+
+	// UIAmount is the token amount adjusted for decimals.
+	UIAmount decimal.Decimal `json:"uiAmount"`
+
+	// Price is the USD price of the token at trade time, if known.
+	Price decimal.Decimal `json:"price"`
+
+	// NearestPrice is the closest known USD price of the token.
+	NearestPrice decimal.Decimal `json:"nearestPrice"`
+
+	// ChangeAmount is the signed raw balance change for the owner.
+	ChangeAmount decimal.Decimal `json:"changeAmount"`
+
+	// UIChangeAmount is the signed balance change adjusted for decimals.
+	UIChangeAmount decimal.Decimal `json:"uiChangeAmount"`
+
+	// Type is the transfer type of the leg (e.g., "transfer", "transferChecked").
+	Type string `json:"type,omitempty"`
+
+	// TypeSwap is the side of the swap this leg is on ("from" or "to").
+	TypeSwap string `json:"typeSwap,omitempty"`
+}
+
+// Time returns the block time of the trade in UTC.
+func (t *Trade) Time() time.Time {
+	return time.Unix(t.BlockUnixTime, 0).UTC()
+}
+
+// Pool returns the pool address the trade executed in.
+//
+// Token trade history reports the pool as PoolID, while pair trade history
+// reports it as Address; Pool returns whichever is set.
+func (t *Trade) Pool() string {
+	if t.PoolID != "" {
+		return t.PoolID
+	}
+	return t.Address
+}
+
+// TradePage is a single page of trade history.
+type TradePage struct {
+	// Items are the trades in this page.
+	Items []Trade `json:"items"`
+
+	// HasNext indicates whether more pages are available.
+	HasNext bool `json:"hasNext"`
+}
+
+// TradeListOptions configures trade history requests.
+//
+// A nil *TradeListOptions uses the API defaults.
+type TradeListOptions struct {
+	// Offset is the number of trades to skip.
+	Offset int
+
+	// Limit is the page size (1-50). Zero uses the maximum.
+	Limit int
+
+	// TxType filters by transaction type. Empty uses the API default (swap).
+	TxType TradeType
+
+	// SortType is the sort direction by block time. Empty uses desc.
+	SortType SortType
+
+	// MaxItems caps the number of trades yielded by iterators.
+	// Zero means no cap. It is ignored by single-page methods.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *TradeListOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxTradeLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 50", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a trade history request.
+func (o *TradeListOptions) params(address string) url.Values {
+	params := url.Values{}
+	params.Set("address", address)
+
+	limit := MaxTradeLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.TxType != "" {
+		params.Set("tx_type", string(o.TxType))
+	}
+	if o.SortType != "" {
+		params.Set("sort_type", string(o.SortType))
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *TradeListOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// ListPairTrades fetches a single page of trade history for a pair (pool).
+//
+// Example:
+//
+//	page, err := client.ListPairTrades(ctx, "Czfq3xZZDmsdGdUyrNLtRhGc47cXcZtLG4crryfu44zE",
+//	    &birdeye.TradeListOptions{Limit: 20})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, trade := range page.Items {
+//	    log.Printf("%s %s via %s", trade.TxHash, trade.Owner, trade.Source)
+//	}
+func (c *Client) ListPairTrades(ctx context.Context, pairAddress string, opts *TradeListOptions) (*TradePage, error) {
+	const path = "/defi/txs/pair"
+
+	if pairAddress == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "pair address is required",
+			Path:       path,
+		}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(pairAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := parseResponse[TradePage](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched pair trades",
+		"address", pairAddress,
+		"count", len(page.Items),
+		"has_next", page.HasNext,
+	)
+
+	return page, nil
+}
+
+// AllPairTrades returns a lazy iterator over the full trade history of a pair.
+//
+// Pages are requested only as the caller consumes them. Set
+// opts.MaxItems to bound the total number of trades.
+//
+// Example:
+//
+//	for trade, err := range client.AllPairTrades(ctx, pairAddress, nil) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    log.Printf("%s at %s", trade.TxHash, trade.Time())
+//	}
+func (c *Client) AllPairTrades(ctx context.Context, pairAddress string, opts *TradeListOptions) iter.Seq2[Trade, error] {
+	page := iteratorOptions(opts, MaxTradeLimit)
+
+	return paginate(ctx, page.Offset, page.MaxItems, func(ctx context.Context, offset int) ([]Trade, bool, error) {
+		pageOpts := page
+		pageOpts.Offset = offset
+
+		trades, err := c.ListPairTrades(ctx, pairAddress, &pageOpts)
+		if err != nil {
+			return nil, false, err
+		}
+		return trades.Items, trades.HasNext, nil
+	})
+}
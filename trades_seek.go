@@ -0,0 +1,369 @@
+package birdeye
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// TradeSeekOptions configures time-bounded trade history requests.
+//
+// A nil *TradeSeekOptions returns the most recent trades.
+type TradeSeekOptions struct {
+	// BeforeTime limits results to trades strictly before this time.
+	// Zero means no upper bound.
+	BeforeTime time.Time
+
+	// AfterTime limits results to trades strictly after this time.
+	// Zero means no lower bound.
+	AfterTime time.Time
+
+	// Offset is the number of trades to skip.
+	Offset int
+
+	// Limit is the page size (1-50). Zero uses the maximum.
+	Limit int
+
+	// TxType filters by transaction type. Empty uses the API default (swap).
+	TxType TradeType
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *TradeSeekOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxTradeLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 50", Path: path}
+	}
+	if !o.BeforeTime.IsZero() && !o.AfterTime.IsZero() && !o.AfterTime.Before(o.BeforeTime) {
+		return &APIError{StatusCode: 400, Message: "after time must be before before time", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a time-bounded trade request.
+func (o *TradeSeekOptions) params(address string) url.Values {
+	params := url.Values{}
+	params.Set("address", address)
+
+	limit := MaxTradeLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.TxType != "" {
+		params.Set("tx_type", string(o.TxType))
+	}
+	if !o.BeforeTime.IsZero() {
+		params.Set("before_time", strconv.FormatInt(o.BeforeTime.Unix(), 10))
+	}
+	if !o.AfterTime.IsZero() {
+		params.Set("after_time", strconv.FormatInt(o.AfterTime.Unix(), 10))
+	}
+
+	return params
+}
+
+// ListTokenTradesByTime fetches a single page of a token's trade history
+// bounded by time.
+//
+// Unlike offset pagination, seeking by time can reach arbitrarily far back
+// in history. Use TokenTradesInWindow to walk a whole time range.
+//
+// Example:
+//
+//	page, err := client.ListTokenTradesByTime(ctx, tokenAddress, &birdeye.TradeSeekOptions{
+//	    BeforeTime: time.Now().Add(-24 * time.Hour),
+//	})
+func (c *Client) ListTokenTradesByTime(ctx context.Context, address string, opts *TradeSeekOptions) (*TradePage, error) {
+	return c.listTradesByTime(ctx, "/defi/txs/token/seek_by_time", address, opts)
+}
+
+// ListPairTradesByTime fetches a single page of a pair's trade history
+// bounded by time.
+//
+// Use PairTradesInWindow to walk a whole time range.
+func (c *Client) ListPairTradesByTime(ctx context.Context, pairAddress string, opts *TradeSeekOptions) (*TradePage, error) {
+	return c.listTradesByTime(ctx, "/defi/txs/pair/seek_by_time", pairAddress, opts)
+}
+
+// listTradesByTime performs a seek_by_time trade history request.
+func (c *Client) listTradesByTime(ctx context.Context, path, address string, opts *TradeSeekOptions) (*TradePage, error) {
+	if address == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "address is required",
+			Path:       path,
+		}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(address))
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := parseResponse[TradePage](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched trades by time",
+		"path", path,
+		"address", address,
+		"count", len(page.Items),
+		"has_next", page.HasNext,
+	)
+
+	return page, nil
+}
+
+// SeekDirection is the direction in which a trade window is walked.
+type SeekDirection int
+
+// Supported seek directions.
+const (
+	// SeekBackward walks from the end of the window towards the start,
+	// yielding the newest trades first.
+	SeekBackward SeekDirection = iota
+
+	// SeekForward walks from the start of the window towards the end,
+	// yielding the oldest trades first.
+	SeekForward
+)
+
+// TradeWindow describes a time range of trade history to walk.
+type TradeWindow struct {
+	// Start is the inclusive start of the window.
+	// Zero means the beginning of history; it is required for SeekForward.
+	Start time.Time
+
+	// End is the exclusive end of the window. Zero means now.
+	End time.Time
+
+	// Direction selects whether the window is walked backward or forward.
+	Direction SeekDirection
+
+	// Limit is the page size (1-50). Zero uses the maximum.
+	Limit int
+
+	// TxType filters by transaction type. Empty uses the API default (swap).
+	TxType TradeType
+
+	// MaxItems caps the number of trades yielded. Zero means no cap.
+	MaxItems int
+}
+
+// TokenTradesInWindow returns a lazy iterator over every trade of a token
+// within the window.
+//
+// The iterator advances a time cursor page by page instead of an offset,
+// so it is not bound by the offset pagination cap. Trades sharing the
+// boundary timestamp between two pages are yielded exactly once. A page
+// Birdeye returns out of the walk's order ends the iteration with an
+// error rather than silently skipping trades.
+//
+// Example:
+//
+//	day := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+//	window := birdeye.TradeWindow{Start: day, End: day.Add(24 * time.Hour)}
+//	for trade, err := range client.TokenTradesInWindow(ctx, tokenAddress, window) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    process(trade)
+//	}
+func (c *Client) TokenTradesInWindow(ctx context.Context, address string, window TradeWindow) iter.Seq2[Trade, error] {
+	return seekTrades(ctx, window, func(ctx context.Context, opts *TradeSeekOptions) (*TradePage, error) {
+		return c.ListTokenTradesByTime(ctx, address, opts)
+	})
+}
+
+// PairTradesInWindow returns a lazy iterator over every trade of a pair
+// within the window.
+//
+// See TokenTradesInWindow for cursor and de-duplication semantics.
+func (c *Client) PairTradesInWindow(ctx context.Context, pairAddress string, window TradeWindow) iter.Seq2[Trade, error] {
+	return seekTrades(ctx, window, func(ctx context.Context, opts *TradeSeekOptions) (*TradePage, error) {
+		return c.ListPairTradesByTime(ctx, pairAddress, opts)
+	})
+}
+
+// seekFunc fetches a single page of time-bounded trade history.
+type seekFunc func(ctx context.Context, opts *TradeSeekOptions) (*TradePage, error)
+
+// tradeKey identifies a trade for de-duplication across page boundaries.
+// A transaction may contain several trades, so the hash alone is not enough.
+type tradeKey struct {
+	txHash string
+	pool   string
+	owner  string
+	side   TradeSide
+	from   string
+	to     string
+}
+
+// keyOf returns the de-duplication key of a trade.
+func keyOf(t *Trade) tradeKey {
+	k := tradeKey{txHash: t.TxHash, pool: t.Pool(), owner: t.Owner, side: t.Side}
+	switch {
+	case t.From != nil && t.To != nil:
+		k.from, k.to = t.From.Amount.String(), t.To.Amount.String()
+	case t.Base != nil && t.Quote != nil:
+		k.from, k.to = t.Base.Amount.String(), t.Quote.Amount.String()
+	}
+	return k
+}
+
+// seekTrades walks a trade window by advancing a time cursor.
+//
+// After each page the cursor moves to the timestamp of the last trade in
+// the page, re-requesting that second so trades sharing it are not lost.
+// Trades already yielded at the boundary second are skipped. If a whole
+// page shares one second, the offset is advanced instead of the cursor.
+//
+// Forward walks send both window bounds on every request. Each page must
+// be ordered in the walk's direction; a page that is not would make the
+// cursor skip trades, so it is reported as an error instead.
+func seekTrades(ctx context.Context, window TradeWindow, fetch seekFunc) iter.Seq2[Trade, error] {
+	return func(yield func(Trade, error) bool) {
+		if window.Direction == SeekForward && window.Start.IsZero() {
+			yield(Trade{}, &APIError{StatusCode: 400, Message: "window start is required to seek forward"})
+			return
+		}
+
+		start, end := window.Start.Unix(), window.End.Unix()
+		if window.Start.IsZero() {
+			start = 0
+		}
+		if window.End.IsZero() {
+			end = 0
+		}
+
+		// The cursor is the exclusive bound sent to the API: before_time when
+		// walking backward, after_time when walking forward.
+		cursor := end
+		if window.Direction == SeekForward {
+			cursor = start - 1
+		}
+
+		offset, yielded := 0, 0
+		boundary := int64(-1)
+		seen := make(map[tradeKey]struct{})
+
+		for {
+			opts := &TradeSeekOptions{Offset: offset, Limit: window.Limit, TxType: window.TxType}
+			if window.Direction == SeekForward {
+				opts.AfterTime = time.Unix(cursor, 0)
+				if end > 0 {
+					opts.BeforeTime = time.Unix(end, 0)
+				}
+			} else if cursor > 0 {
+				opts.BeforeTime = time.Unix(cursor, 0)
+			}
+
+			page, err := fetch(ctx, opts)
+			if err != nil {
+				yield(Trade{}, err)
+				return
+			}
+
+			if i, ok := outOfOrder(page.Items, window.Direction); ok {
+				yield(Trade{}, fmt.Errorf("trade page out of order: %s at %d follows %d",
+					page.Items[i].TxHash, page.Items[i].BlockUnixTime, page.Items[i-1].BlockUnixTime))
+				return
+			}
+
+			done := !page.HasNext || len(page.Items) == 0
+			next := cursor
+			for i := range page.Items {
+				trade := page.Items[i]
+				ts := trade.BlockUnixTime
+
+				if window.Direction == SeekForward {
+					if end > 0 && ts >= end {
+						done = true
+						continue
+					}
+					next = max(next, ts)
+				} else {
+					if ts < start {
+						done = true
+						continue
+					}
+					if next == 0 || ts < next {
+						next = ts
+					}
+				}
+
+				key := keyOf(&trade)
+				if ts == boundary {
+					if _, ok := seen[key]; ok {
+						continue
+					}
+				}
+
+				if window.MaxItems > 0 && yielded >= window.MaxItems {
+					return
+				}
+				if !yield(trade, nil) {
+					return
+				}
+				yielded++
+
+				if ts != boundary {
+					boundary = ts
+					clear(seen)
+				}
+				seen[key] = struct{}{}
+			}
+
+			if done || (window.MaxItems > 0 && yielded >= window.MaxItems) {
+				return
+			}
+
+			// Re-request the boundary second so trades sharing it with the
+			// last trade of this page are included.
+			if window.Direction == SeekForward {
+				next--
+			} else {
+				next++
+			}
+
+			if next == cursor {
+				offset += len(page.Items)
+			} else {
+				cursor, offset = next, 0
+			}
+		}
+	}
+}
+
+// outOfOrder returns the index of the first trade that is out of order for
+// the seek direction: older than its predecessor when walking forward, or
+// newer when walking backward.
+func outOfOrder(trades []Trade, direction SeekDirection) (int, bool) {
+	for i := 1; i < len(trades); i++ {
+		prev, ts := trades[i-1].BlockUnixTime, trades[i].BlockUnixTime
+		if (direction == SeekForward && ts < prev) || (direction == SeekBackward && ts > prev) {
+			return i, true
+		}
+	}
+	return 0, false
+}
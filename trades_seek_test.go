@@ -0,0 +1,325 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// seekServer serves seek_by_time requests over a fixed trade history.
+// before_time and after_time are exclusive; before_time results are newest
+// first and after_time results oldest first.
+func seekServer(t *testing.T, times []int64) (*httptest.Server, *int) {
+	t.Helper()
+	return seekServerOrdered(t, times, false)
+}
+
+// seekServerOrdered is seekServer, optionally returning after_time results
+// newest first as well.
+func seekServerOrdered(t *testing.T, times []int64, newestFirst bool) (*httptest.Server, *int) {
+	t.Helper()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+
+		type entry struct {
+			idx int
+			ts  int64
+		}
+		var matched []entry
+		for i, ts := range times {
+			if v := q.Get("before_time"); v != "" {
+				b, _ := strconv.ParseInt(v, 10, 64)
+				if ts >= b {
+					continue
+				}
+			}
+			if v := q.Get("after_time"); v != "" {
+				a, _ := strconv.ParseInt(v, 10, 64)
+				if ts <= a {
+					continue
+				}
+			}
+			matched = append(matched, entry{i, ts})
+		}
+
+		asc := q.Get("after_time") != "" && !newestFirst
+		sort.SliceStable(matched, func(i, j int) bool {
+			if asc {
+				return matched[i].ts < matched[j].ts
+			}
+			return matched[i].ts > matched[j].ts
+		})
+
+		items := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < len(matched); i++ {
+			items = append(items, map[string]interface{}{
+				"txHash":        "tx" + strconv.Itoa(matched[i].idx),
+				"blockUnixTime": matched[i].ts,
+			})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{
+			"items":   items,
+			"hasNext": offset+limit < len(matched),
+		}))
+	}))
+
+	return server, &requests
+}
+
+func collectTrades(t *testing.T, seq func(func(Trade, error) bool)) []Trade {
+	t.Helper()
+
+	var trades []Trade
+	for trade, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		trades = append(trades, trade)
+	}
+	return trades
+}
+
+// boundaryHeavyTimes has several trades per second, including more trades
+// in one second than fit in a page.
+var boundaryHeavyTimes = []int64{100, 101, 101, 102, 103, 103, 103, 103, 103, 104, 105, 105, 106}
+
+func TestTokenTradesInWindow_Backward(t *testing.T) {
+	server, _ := seekServer(t, boundaryHeavyTimes)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	window := TradeWindow{
+		Start: time.Unix(101, 0),
+		End:   time.Unix(106, 0),
+		Limit: 3,
+	}
+	trades := collectTrades(t, client.TokenTradesInWindow(context.Background(), "Token", window))
+
+	// Window [101, 106) contains every trade except those at 100 and 106.
+	if len(trades) != 11 {
+		t.Fatalf("expected 11 trades, got %d", len(trades))
+	}
+
+	seen := map[string]bool{}
+	for i, trade := range trades {
+		if seen[trade.TxHash] {
+			t.Errorf("duplicate trade %s", trade.TxHash)
+		}
+		seen[trade.TxHash] = true
+
+		if i > 0 && trade.BlockUnixTime > trades[i-1].BlockUnixTime {
+			t.Errorf("expected newest first, got %d after %d", trade.BlockUnixTime, trades[i-1].BlockUnixTime)
+		}
+	}
+}
+
+func TestPairTradesInWindow_Forward(t *testing.T) {
+	server, _ := seekServer(t, boundaryHeavyTimes)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	window := TradeWindow{
+		Start:     time.Unix(101, 0),
+		End:       time.Unix(106, 0),
+		Direction: SeekForward,
+		Limit:     2,
+	}
+	trades := collectTrades(t, client.PairTradesInWindow(context.Background(), "Pair", window))
+
+	if len(trades) != 11 {
+		t.Fatalf("expected 11 trades, got %d", len(trades))
+	}
+
+	seen := map[string]bool{}
+	for i, trade := range trades {
+		if seen[trade.TxHash] {
+			t.Errorf("duplicate trade %s", trade.TxHash)
+		}
+		seen[trade.TxHash] = true
+
+		if i > 0 && trade.BlockUnixTime < trades[i-1].BlockUnixTime {
+			t.Errorf("expected oldest first, got %d after %d", trade.BlockUnixTime, trades[i-1].BlockUnixTime)
+		}
+	}
+}
+
+func TestPairTradesInWindow_ForwardSendsBothBounds(t *testing.T) {
+	var queries []map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": [], "hasNext": false}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	window := TradeWindow{Start: time.Unix(101, 0), End: time.Unix(106, 0), Direction: SeekForward}
+	collectTrades(t, client.PairTradesInWindow(context.Background(), "Pair", window))
+
+	if len(queries) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(queries))
+	}
+	if got := queries[0]["after_time"]; len(got) != 1 || got[0] != "100" {
+		t.Errorf("expected after_time=100, got %v", got)
+	}
+	if got := queries[0]["before_time"]; len(got) != 1 || got[0] != "106" {
+		t.Errorf("expected before_time=106, got %v", got)
+	}
+}
+
+func TestPairTradesInWindow_ForwardNewestFirst(t *testing.T) {
+	server, _ := seekServerOrdered(t, boundaryHeavyTimes, true)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	window := TradeWindow{
+		Start:     time.Unix(101, 0),
+		End:       time.Unix(106, 0),
+		Direction: SeekForward,
+		Limit:     3,
+	}
+
+	var (
+		yielded int
+		lastErr error
+	)
+	for _, err := range client.PairTradesInWindow(context.Background(), "Pair", window) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		yielded++
+	}
+
+	if lastErr == nil {
+		t.Fatal("expected error for newest-first forward page")
+	}
+	if yielded != 0 {
+		t.Errorf("expected no trades before the error, got %d", yielded)
+	}
+}
+
+func TestTokenTradesInWindow_OpenEnded(t *testing.T) {
+	server, _ := seekServer(t, boundaryHeavyTimes)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	trades := collectTrades(t, client.TokenTradesInWindow(context.Background(), "Token", TradeWindow{Limit: 4}))
+
+	if len(trades) != len(boundaryHeavyTimes) {
+		t.Errorf("expected %d trades, got %d", len(boundaryHeavyTimes), len(trades))
+	}
+}
+
+func TestTokenTradesInWindow_MaxItems(t *testing.T) {
+	server, requests := seekServer(t, boundaryHeavyTimes)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	trades := collectTrades(t, client.TokenTradesInWindow(context.Background(), "Token", TradeWindow{Limit: 3, MaxItems: 3}))
+
+	if len(trades) != 3 {
+		t.Errorf("expected 3 trades, got %d", len(trades))
+	}
+	if *requests != 1 {
+		t.Errorf("expected 1 request, got %d", *requests)
+	}
+}
+
+func TestTradesInWindow_ForwardRequiresStart(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	for _, err := range client.TokenTradesInWindow(context.Background(), "Token", TradeWindow{Direction: SeekForward}) {
+		apiErr, ok := IsAPIError(err)
+		if !ok || apiErr.StatusCode != 400 {
+			t.Errorf("expected 400 APIError, got %v", err)
+		}
+	}
+}
+
+func TestListTokenTradesByTime_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/defi/txs/token/seek_by_time" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": [], "hasNext": false}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListTokenTradesByTime(context.Background(), "Token", &TradeSeekOptions{
+		BeforeTime: time.Unix(2000, 0),
+		AfterTime:  time.Unix(1000, 0),
+		Limit:      10,
+		TxType:     TradeTypeSwap,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"address":     "Token",
+		"before_time": "2000",
+		"after_time":  "1000",
+		"limit":       "10",
+		"tx_type":     "swap",
+	}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestListTradesByTime_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name    string
+		address string
+		opts    *TradeSeekOptions
+	}{
+		{"empty address", "", nil},
+		{"limit too large", "Token", &TradeSeekOptions{Limit: 100}},
+		{"inverted range", "Token", &TradeSeekOptions{BeforeTime: time.Unix(10, 0), AfterTime: time.Unix(20, 0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListPairTradesByTime(context.Background(), tt.address, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != 400 {
+				t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestListPairTradesByTime_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/txs/pair/seek_by_time": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListPairTradesByTime(context.Background(), "Pair", nil)
+	if err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
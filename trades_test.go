@@ -0,0 +1,253 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestListPairTrades_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/txs/pair": wrapResponse(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{
+					"txHash":        "tx1",
+					"source":        "raydium",
+					"blockUnixTime": 1726676178,
+					"txType":        "swap",
+					"address":       "PairAddr",
+					"owner":         "OwnerAddr",
+					"from": map[string]interface{}{
+						"symbol":         "SOL",
+						"decimals":       9,
+						"address":        "So11111111111111111111111111111111111111112",
+						"amount":         103150000,
+						"uiAmount":       0.10315,
+						"price":          nil,
+						"nearestPrice":   134.07,
+						"changeAmount":   -103150000,
+						"uiChangeAmount": -0.10315,
+						"type":           "transfer",
+						"typeSwap":       "from",
+					},
+					"to": map[string]interface{}{
+						"symbol":         "BONK",
+						"decimals":       5,
+						"address":        "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263",
+						"amount":         "123456789012345678",
+						"uiAmount":       "1234567890123.45678",
+						"typeSwap":       "to",
+						"uiChangeAmount": "1234567890123.45678",
+					},
+				},
+			},
+			"hasNext": true,
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	page, err := client.ListPairTrades(context.Background(), "PairAddr", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !page.HasNext {
+		t.Error("expected hasNext to be true")
+	}
+	if len(page.Items) != 1 {
+		t.Fatalf("expected 1 trade, got %d", len(page.Items))
+	}
+
+	trade := page.Items[0]
+	if trade.Pool() != "PairAddr" {
+		t.Errorf("expected pool 'PairAddr', got '%s'", trade.Pool())
+	}
+	if trade.Time().Unix() != 1726676178 {
+		t.Errorf("expected time 1726676178, got %d", trade.Time().Unix())
+	}
+	if trade.From == nil || trade.From.TypeSwap != "from" {
+		t.Fatal("expected from leg with typeSwap 'from'")
+	}
+	if !trade.From.UIChangeAmount.Equal(decimal.RequireFromString("-0.10315")) {
+		t.Errorf("expected from uiChangeAmount -0.10315, got %s", trade.From.UIChangeAmount)
+	}
+	if !trade.From.Price.IsZero() {
+		t.Errorf("expected null price to decode as zero, got %s", trade.From.Price)
+	}
+	if trade.To == nil || trade.To.UIAmount.String() != "1234567890123.45678" {
+		t.Errorf("expected precise to uiAmount, got %v", trade.To)
+	}
+}
+
+func TestListPairTrades_Params(t *testing.T) {
+	var got map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		got = map[string]string{
+			"address":   q.Get("address"),
+			"offset":    q.Get("offset"),
+			"limit":     q.Get("limit"),
+			"tx_type":   q.Get("tx_type"),
+			"sort_type": q.Get("sort_type"),
+		}
+		_, _ = w.Write([]byte(`{"success": true, "data": {"items": [], "hasNext": false}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListPairTrades(context.Background(), "PairAddr", &TradeListOptions{
+		Offset:   100,
+		Limit:    20,
+		TxType:   TradeTypeAll,
+		SortType: SortAsc,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"address":   "PairAddr",
+		"offset":    "100",
+		"limit":     "20",
+		"tx_type":   "all",
+		"sort_type": "asc",
+	}
+	for k, v := range expected {
+		if got[k] != v {
+			t.Errorf("expected %s=%s, got %s", k, v, got[k])
+		}
+	}
+}
+
+func TestListPairTrades_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name    string
+		address string
+		opts    *TradeListOptions
+	}{
+		{"empty address", "", nil},
+		{"negative offset", "PairAddr", &TradeListOptions{Offset: -1}},
+		{"limit too large", "PairAddr", &TradeListOptions{Limit: 51}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListPairTrades(context.Background(), tt.address, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.StatusCode != 400 {
+				t.Errorf("expected status 400, got %d", apiErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestListPairTrades_NotFound(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/txs/pair": 404,
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListPairTrades(context.Background(), "PairAddr", nil)
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if !apiErr.IsNotFound() {
+		t.Error("expected IsNotFound to be true")
+	}
+}
+
+func TestListPairTrades_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/txs/pair": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.ListPairTrades(context.Background(), "PairAddr", nil)
+	if err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllPairTrades_Paginates(t *testing.T) {
+	const total = 7
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := []map[string]interface{}{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, map[string]interface{}{"txHash": "tx" + strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{
+			"items":   items,
+			"hasNext": offset+limit < total,
+		}))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	var hashes []string
+	for trade, err := range client.AllPairTrades(context.Background(), "PairAddr", &TradeListOptions{Limit: 3}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		hashes = append(hashes, trade.TxHash)
+	}
+
+	if len(hashes) != total {
+		t.Fatalf("expected %d trades, got %d", total, len(hashes))
+	}
+	for i, h := range hashes {
+		if h != "tx"+strconv.Itoa(i) {
+			t.Errorf("expected tx%d at position %d, got %s", i, i, h)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestAllPairTrades_Error(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/txs/pair": 500,
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	var errs int
+	for _, err := range client.AllPairTrades(context.Background(), "PairAddr", nil) {
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("expected exactly one error, got %d", errs)
+	}
+}
@@ -0,0 +1,235 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxTrendingLimit is the maximum number of trending tokens Birdeye returns per page.
+const MaxTrendingLimit = 20
+
+// TrendingSortField is the field the trending list is sorted by.
+type TrendingSortField string
+
+// Supported trending sort fields.
+const (
+	TrendingSortRank      TrendingSortField = "rank"
+	TrendingSortVolume    TrendingSortField = "volume24hUSD"
+	TrendingSortLiquidity TrendingSortField = "liquidity"
+)
+
+// TrendingToken is a single entry in Birdeye's trending list.
+type TrendingToken struct {
+	// Rank is the token's position in the trending list (1 is highest).
+	Rank int `json:"rank"`
+
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logoURI"`
+
+	// Price is the current price in USD.
+	Price decimal.Decimal `json:"price"`
+
+	// Liquidity is the total liquidity in USD across all pools.
+	Liquidity decimal.Decimal `json:"liquidity"`
+
+	// Volume24hUSD is the 24-hour trading volume in USD.
+	Volume24hUSD decimal.Decimal `json:"volume24hUSD"`
+
+	// Volume24hChangePercent is the change in volume vs previous 24h.
+	Volume24hChangePercent decimal.Decimal `json:"volume24hChangePercent"`
+
+	// Price24hChangePercent is the 24-hour price change percentage.
+	Price24hChangePercent decimal.Decimal `json:"price24hChangePercent"`
+}
+
+// TrendingPage is a single page of the trending list.
+type TrendingPage struct {
+	// Tokens are the trending tokens in this page.
+	Tokens []TrendingToken `json:"tokens"`
+
+	// Total is the total number of trending tokens.
+	Total int `json:"total"`
+
+	// UpdateUnixTime is when the list was last updated (Unix timestamp).
+	UpdateUnixTime int64 `json:"updateUnixTime"`
+
+	// UpdateTime is a human-readable update timestamp.
+	UpdateTime string `json:"updateTime"`
+}
+
+// TrendingOptions configures trending token requests.
+//
+// A nil *TrendingOptions returns the top tokens by rank.
+type TrendingOptions struct {
+	// SortBy is the field to sort by. Empty uses TrendingSortRank.
+	SortBy TrendingSortField
+
+	// SortType is the sort direction. Empty uses asc, so rank 1 comes first.
+	SortType SortType
+
+	// Offset is the number of tokens to skip.
+	Offset int
+
+	// Limit is the page size (1-20). Zero uses the maximum.
+	Limit int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *TrendingOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Offset < 0 {
+		return &APIError{StatusCode: 400, Message: "offset must not be negative", Path: path}
+	}
+	if o.Limit < 0 || o.Limit > MaxTrendingLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 20", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a trending request.
+func (o *TrendingOptions) params() url.Values {
+	params := url.Values{}
+	params.Set("sort_by", string(TrendingSortRank))
+	params.Set("sort_type", string(SortAsc))
+
+	limit := MaxTrendingLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o == nil {
+		return params
+	}
+	if o.SortBy != "" {
+		params.Set("sort_by", string(o.SortBy))
+	}
+	if o.SortType != "" {
+		params.Set("sort_type", string(o.SortType))
+	}
+	if o.Offset > 0 {
+		params.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return params
+}
+
+// GetTrendingTokens fetches a page of Birdeye's trending tokens.
+//
+// Example:
+//
+//	page, err := client.GetTrendingTokens(ctx, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, token := range page.Tokens {
+//	    log.Printf("#%d %s $%s", token.Rank, token.Symbol, token.Price)
+//	}
+func (c *Client) GetTrendingTokens(ctx context.Context, opts *TrendingOptions) (*TrendingPage, error) {
+	const path = "/defi/token_trending"
+
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := parseResponse[TrendingPage](body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("fetched trending tokens",
+		"count", len(page.Tokens),
+		"total", page.Total,
+	)
+
+	return page, nil
+}
+
+// TrendingMove records a token whose rank changed between two snapshots.
+type TrendingMove struct {
+	// Token is the token as it appears in the newer snapshot.
+	Token TrendingToken
+
+	// PreviousRank is the token's rank in the older snapshot.
+	PreviousRank int
+}
+
+// Delta returns the number of places the token moved.
+// Positive values mean the token climbed (its rank number decreased).
+func (m TrendingMove) Delta() int {
+	return m.PreviousRank - m.Token.Rank
+}
+
+// TrendingDiff describes the changes between two trending snapshots.
+type TrendingDiff struct {
+	// Entered are tokens present only in the newer snapshot.
+	Entered []TrendingToken
+
+	// Exited are tokens present only in the older snapshot.
+	Exited []TrendingToken
+
+	// Moved are tokens present in both snapshots with a different rank.
+	Moved []TrendingMove
+}
+
+// DiffTrending compares two trending snapshots and reports tokens that
+// entered, exited or changed rank. Tokens are matched by address and each
+// result slice keeps the order of the snapshot it was taken from.
+//
+// Example:
+//
+//	diff := birdeye.DiffTrending(previous.Tokens, current.Tokens)
+//	for _, token := range diff.Entered {
+//	    log.Printf("%s entered trending at #%d", token.Symbol, token.Rank)
+//	}
+func DiffTrending(previous, current []TrendingToken) TrendingDiff {
+	prevRanks := make(map[string]int, len(previous))
+	for _, token := range previous {
+		prevRanks[token.Address] = token.Rank
+	}
+
+	currAddrs := make(map[string]struct{}, len(current))
+	var diff TrendingDiff
+
+	for _, token := range current {
+		currAddrs[token.Address] = struct{}{}
+
+		prevRank, ok := prevRanks[token.Address]
+		switch {
+		case !ok:
+			diff.Entered = append(diff.Entered, token)
+		case prevRank != token.Rank:
+			diff.Moved = append(diff.Moved, TrendingMove{Token: token, PreviousRank: prevRank})
+		}
+	}
+
+	for _, token := range previous {
+		if _, ok := currAddrs[token.Address]; !ok {
+			diff.Exited = append(diff.Exited, token)
+		}
+	}
+
+	return diff
+}
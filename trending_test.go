@@ -0,0 +1,158 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetTrendingTokens_Success(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/token_trending": wrapResponse(map[string]interface{}{
+			"updateUnixTime": 1726681733,
+			"updateTime":     "2024-09-18T17:48:53",
+			"total":          1000,
+			"tokens": []map[string]interface{}{
+				{
+					"rank":                   1,
+					"address":                "TrendToken1",
+					"symbol":                 "TREND",
+					"name":                   "Trending",
+					"decimals":               9,
+					"price":                  0.00123456789,
+					"liquidity":              250000.5,
+					"volume24hUSD":           5000000,
+					"volume24hChangePercent": 312.75,
+				},
+			},
+		}),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	page, err := client.GetTrendingTokens(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if page.Total != 1000 || len(page.Tokens) != 1 {
+		t.Fatalf("expected 1 token of 1000, got %d of %d", len(page.Tokens), page.Total)
+	}
+
+	token := page.Tokens[0]
+	if token.Rank != 1 || token.Symbol != "TREND" {
+		t.Errorf("unexpected token: %+v", token)
+	}
+	if !token.Price.Equal(decimal.RequireFromString("0.00123456789")) {
+		t.Errorf("expected price 0.00123456789, got %s", token.Price)
+	}
+	if !token.Volume24hChangePercent.Equal(decimal.RequireFromString("312.75")) {
+		t.Errorf("expected volume change 312.75, got %s", token.Volume24hChangePercent)
+	}
+}
+
+func TestGetTrendingTokens_Params(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"success": true, "data": {"tokens": [], "total": 0}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTrendingTokens(context.Background(), &TrendingOptions{
+		SortBy:   TrendingSortVolume,
+		SortType: SortDesc,
+		Offset:   20,
+		Limit:    10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"sort_by":   "volume24hUSD",
+		"sort_type": "desc",
+		"offset":    "20",
+		"limit":     "10",
+	}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+}
+
+func TestGetTrendingTokens_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	for _, opts := range []*TrendingOptions{{Offset: -1}, {Limit: 21}} {
+		_, err := client.GetTrendingTokens(context.Background(), opts)
+		apiErr, ok := IsAPIError(err)
+		if !ok || apiErr.StatusCode != 400 {
+			t.Errorf("expected 400 APIError for %+v, got %v", opts, err)
+		}
+	}
+}
+
+func TestGetTrendingTokens_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/defi/token_trending": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	_, err := client.GetTrendingTokens(context.Background(), nil)
+	if err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestDiffTrending(t *testing.T) {
+	previous := []TrendingToken{
+		{Rank: 1, Address: "A"},
+		{Rank: 2, Address: "B"},
+		{Rank: 3, Address: "C"},
+	}
+	current := []TrendingToken{
+		{Rank: 1, Address: "C"},
+		{Rank: 2, Address: "B"},
+		{Rank: 3, Address: "D"},
+	}
+
+	diff := DiffTrending(previous, current)
+
+	if len(diff.Entered) != 1 || diff.Entered[0].Address != "D" {
+		t.Errorf("expected D to enter, got %+v", diff.Entered)
+	}
+	if len(diff.Exited) != 1 || diff.Exited[0].Address != "A" {
+		t.Errorf("expected A to exit, got %+v", diff.Exited)
+	}
+	if len(diff.Moved) != 1 || diff.Moved[0].Token.Address != "C" {
+		t.Fatalf("expected C to move, got %+v", diff.Moved)
+	}
+	if diff.Moved[0].PreviousRank != 3 || diff.Moved[0].Delta() != 2 {
+		t.Errorf("expected C to climb 2 places from #3, got %+v delta %d", diff.Moved[0], diff.Moved[0].Delta())
+	}
+}
+
+func TestDiffTrending_Empty(t *testing.T) {
+	current := []TrendingToken{{Rank: 1, Address: "A"}}
+
+	diff := DiffTrending(nil, current)
+	if len(diff.Entered) != 1 || len(diff.Exited) != 0 || len(diff.Moved) != 0 {
+		t.Errorf("expected only entries, got %+v", diff)
+	}
+
+	diff = DiffTrending(current, nil)
+	if len(diff.Entered) != 0 || len(diff.Exited) != 1 || len(diff.Moved) != 0 {
+		t.Errorf("expected only exits, got %+v", diff)
+	}
+}
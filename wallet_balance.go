@@ -0,0 +1,158 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/shopspring/decimal"
+)
+
+// WalletTokenBalance is a wallet's balance of a single token.
+type WalletTokenBalance struct {
+	// Wallet is the wallet address.
+	Wallet string `json:"-"`
+
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Balance is the raw token balance (not adjusted for decimals).
+	Balance decimal.Decimal `json:"balance"`
+
+	// UIAmount is the balance adjusted for decimals.
+	UIAmount decimal.Decimal `json:"-"`
+
+	// PriceUSD is the token's current price in USD.
+	PriceUSD decimal.Decimal `json:"priceUsd"`
+
+	// ValueUSD is the balance's value in USD.
+	ValueUSD decimal.Decimal `json:"valueUsd"`
+}
+
+// WalletBalanceResult is the outcome of fetching one wallet's balance in
+// GetWalletTokenBalances.
+type WalletBalanceResult struct {
+	// Balance is the wallet's balance. It is nil if Err is set.
+	Balance *WalletTokenBalance
+
+	// Err is the error fetching this wallet's balance, if any.
+	Err error
+}
+
+// GetWalletTokenBalance fetches a wallet's balance of a single token.
+//
+// A wallet that does not hold the token has a zero balance.
+//
+// Example:
+//
+//	bal, err := client.GetWalletTokenBalance(ctx, walletAddress, tokenAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	if bal.UIAmount.LessThan(orderSize) {
+//	    return errors.New("insufficient balance")
+//	}
+func (c *Client) GetWalletTokenBalance(ctx context.Context, wallet, mint string) (*WalletTokenBalance, error) {
+	const path = "/v1/wallet/token_balance"
+
+	if wallet == "" {
+		return nil, &APIError{StatusCode: 400, Message: "wallet is required", Path: path}
+	}
+	if mint == "" {
+		return nil, &APIError{StatusCode: 400, Message: "token address is required", Path: path}
+	}
+
+	params := url.Values{}
+	params.Set("wallet", wallet)
+	params.Set("token_address", mint)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	bal, err := parseResponse[WalletTokenBalance](body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Birdeye returns success with null data when the wallet has no
+	// account for the token.
+	if bal.Address == "" {
+		bal.Address = mint
+	}
+	bal.Wallet = wallet
+	bal.UIAmount = uiAmount(bal.Balance, bal.Decimals)
+
+	c.logger.Debug("fetched wallet token balance",
+		"wallet", wallet,
+		"mint", mint,
+		"ui_amount", bal.UIAmount.String(),
+	)
+
+	return bal, nil
+}
+
+// GetWalletTokenBalances fetches the balance of a single token across
+// many wallets concurrently.
+//
+// At most WithMaxConcurrency requests are in flight at a time. A failure
+// for one wallet does not stop the others; each wallet's outcome is
+// reported in its WalletBalanceResult. The returned error is non-nil only
+// for invalid arguments.
+//
+// Returns a map of wallet address -> result. Duplicate wallets are
+// fetched once.
+//
+// Example:
+//
+//	results, err := client.GetWalletTokenBalances(ctx, treasuryWallets, usdcMint)
+//	if err != nil {
+//	    return err
+//	}
+//	for wallet, r := range results {
+//	    if r.Err != nil {
+//	        log.Printf("%s: %v", wallet, r.Err)
+//	        continue
+//	    }
+//	    total = total.Add(r.Balance.UIAmount)
+//	}
+func (c *Client) GetWalletTokenBalances(ctx context.Context, wallets []string, mint string) (map[string]WalletBalanceResult, error) {
+	const path = "/v1/wallet/token_balance"
+
+	if mint == "" {
+		return nil, &APIError{StatusCode: 400, Message: "token address is required", Path: path}
+	}
+	for _, wallet := range wallets {
+		if wallet == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "wallet list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	balances, errs := fetchEach(ctx, c, wallets,
+		func(ctx context.Context, wallet string) (*WalletTokenBalance, error) {
+			return c.GetWalletTokenBalance(ctx, wallet, mint)
+		})
+
+	results := make(map[string]WalletBalanceResult, len(balances)+len(errs))
+	for wallet, bal := range balances {
+		results[wallet] = WalletBalanceResult{Balance: bal}
+	}
+	for wallet, err := range errs {
+		results[wallet] = WalletBalanceResult{Err: err}
+	}
+
+	c.logger.Debug("fetched wallet token balances",
+		"mint", mint,
+		"wallets", len(results),
+		"failed", len(errs),
+	)
+
+	return results, nil
+}
@@ -0,0 +1,183 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetWalletTokenBalance_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"address": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+				"decimals": 6,
+				"balance": 1234567891,
+				"uiAmount": 1234.567891,
+				"chainId": "solana",
+				"priceUsd": 0.9999,
+				"valueUsd": 1234.44443
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	bal, err := client.GetWalletTokenBalance(context.Background(), "Wallet123", "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["wallet"][0] != "Wallet123" || query["token_address"][0] != "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if bal.Wallet != "Wallet123" {
+		t.Errorf("expected wallet Wallet123, got %s", bal.Wallet)
+	}
+	if bal.UIAmount.String() != "1234.567891" {
+		t.Errorf("expected ui amount 1234.567891, got %s", bal.UIAmount)
+	}
+	if !bal.ValueUSD.Equal(decimal.RequireFromString("1234.44443")) {
+		t.Errorf("expected value 1234.44443, got %s", bal.ValueUSD)
+	}
+}
+
+func TestGetWalletTokenBalance_NullData(t *testing.T) {
+	responses := map[string]interface{}{
+		"/v1/wallet/token_balance": wrapResponse(nil),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	bal, err := client.GetWalletTokenBalance(context.Background(), "Wallet123", "Mint")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if bal.Address != "Mint" || !bal.UIAmount.IsZero() {
+		t.Errorf("expected zero balance of Mint, got %+v", bal)
+	}
+}
+
+func TestGetWalletTokenBalance_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name   string
+		wallet string
+		mint   string
+	}{
+		{"empty wallet", "", "Mint"},
+		{"empty mint", "Wallet", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetWalletTokenBalance(context.Background(), tt.wallet, tt.mint)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetWalletTokenBalances_PerWalletErrors(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Query().Get("wallet") == "bad" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"success": false, "message": "forbidden"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": true, "data": {"address": "Mint", "decimals": 2, "balance": 150}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL, WithMaxConcurrency(2))
+
+	wallets := []string{"w1", "w2", "bad", "w3", "w4", "w1"}
+	results, err := client.GetWalletTokenBalances(context.Background(), wallets, "Mint")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	for _, wallet := range []string{"w1", "w2", "w3", "w4"} {
+		r := results[wallet]
+		if r.Err != nil {
+			t.Errorf("%s: unexpected error: %v", wallet, r.Err)
+			continue
+		}
+		if r.Balance.Wallet != wallet || r.Balance.UIAmount.String() != "1.5" {
+			t.Errorf("%s: unexpected balance: %+v", wallet, r.Balance)
+		}
+	}
+
+	bad := results["bad"]
+	if apiErr, ok := IsAPIError(bad.Err); !ok || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected 403 APIError for bad wallet, got %v", bad.Err)
+	}
+	if bad.Balance != nil {
+		t.Errorf("expected nil balance on error, got %+v", bad.Balance)
+	}
+
+	if p := peak.Load(); p > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", p)
+	}
+}
+
+func TestGetWalletTokenBalances_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	if _, err := client.GetWalletTokenBalances(context.Background(), []string{"a", ""}, "Mint"); err == nil {
+		t.Error("expected error for empty wallet")
+	}
+	if _, err := client.GetWalletTokenBalances(context.Background(), []string{"a"}, ""); err == nil {
+		t.Error("expected error for empty mint")
+	}
+
+	results, err := client.GetWalletTokenBalances(context.Background(), nil, "Mint")
+	if err != nil || len(results) != 0 {
+		t.Errorf("expected empty result for empty list, got %v, %v", results, err)
+	}
+}
+
+func TestGetWalletTokenBalances_Canceled(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := client.GetWalletTokenBalances(ctx, []string{"w1", "w2"}, "Mint")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for wallet, r := range results {
+		if r.Err == nil {
+			t.Errorf("%s: expected error for canceled context", wallet)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxNetWorthHistoryCount is the maximum number of points a net worth
+// history request returns.
+const MaxNetWorthHistoryCount = 30
+
+// NetWorthInterval is the spacing between net worth history points.
+type NetWorthInterval string
+
+// Supported net worth history intervals.
+const (
+	NetWorthInterval1h NetWorthInterval = "1h"
+	NetWorthInterval1d NetWorthInterval = "1d"
+)
+
+// NetWorthHolding is a single token counted in a wallet's net worth.
+type NetWorthHolding struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logo_uri"`
+
+	// Balance is the raw token balance (not adjusted for decimals).
+	Balance decimal.Decimal `json:"balance"`
+
+	// Amount is the balance adjusted for decimals.
+	Amount decimal.Decimal `json:"amount"`
+
+	// Price is the token's current price in USD.
+	Price decimal.Decimal `json:"price"`
+
+	// Value is the holding's value in USD.
+	Value decimal.Decimal `json:"value"`
+}
+
+// WalletNetWorth is a wallet's current net worth.
+type WalletNetWorth struct {
+	// Wallet is the wallet address.
+	Wallet string `json:"wallet_address"`
+
+	// Currency is the valuation currency (e.g., "usd").
+	Currency string `json:"currency"`
+
+	// TotalValue is the wallet's total value.
+	TotalValue decimal.Decimal `json:"total_value"`
+
+	// Timestamp is when the valuation was computed.
+	Timestamp time.Time `json:"current_timestamp"`
+
+	// Items are the holdings counted in the valuation.
+	Items []NetWorthHolding `json:"items"`
+}
+
+// NetWorthPoint is a wallet's net worth at a point in time.
+type NetWorthPoint struct {
+	// Time is the point's timestamp.
+	Time time.Time `json:"timestamp"`
+
+	// NetWorth is the wallet's total value.
+	NetWorth decimal.Decimal `json:"net_worth"`
+
+	// Change is the change in value since the previous point.
+	Change decimal.Decimal `json:"net_worth_change"`
+
+	// ChangePercent is the change since the previous point as a percentage.
+	ChangePercent decimal.Decimal `json:"net_worth_change_percent"`
+}
+
+// NetWorthHistoryOptions configures net worth history requests.
+//
+// A nil *NetWorthHistoryOptions returns daily points for the last 7 days.
+type NetWorthHistoryOptions struct {
+	// Interval is the spacing between points. Empty uses NetWorthInterval1d.
+	Interval NetWorthInterval
+
+	// Count is the number of points (1-30). Zero uses 7.
+	Count int
+
+	// Before is the time the history ends at. Zero means now.
+	Before time.Time
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *NetWorthHistoryOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	switch o.Interval {
+	case "", NetWorthInterval1h, NetWorthInterval1d:
+	default:
+		return &APIError{StatusCode: 400, Message: "unsupported interval: " + string(o.Interval), Path: path}
+	}
+	if o.Count < 0 || o.Count > MaxNetWorthHistoryCount {
+		return &APIError{StatusCode: 400, Message: "count must be between 1 and 30", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a net worth history request.
+func (o *NetWorthHistoryOptions) params(wallet string) url.Values {
+	params := url.Values{}
+	params.Set("wallet", wallet)
+	params.Set("type", string(NetWorthInterval1d))
+	params.Set("count", "7")
+	params.Set("direction", "back")
+
+	if o == nil {
+		return params
+	}
+	if o.Interval != "" {
+		params.Set("type", string(o.Interval))
+	}
+	if o.Count > 0 {
+		params.Set("count", strconv.Itoa(o.Count))
+	}
+	if !o.Before.IsZero() {
+		params.Set("time", o.Before.UTC().Format(time.RFC3339))
+	}
+
+	return params
+}
+
+// WalletNetWorthResult is the outcome of fetching one wallet's net worth in
+// GetWalletNetWorths.
+type WalletNetWorthResult struct {
+	// NetWorth is the wallet's net worth. It is nil if Err is set.
+	NetWorth *WalletNetWorth
+
+	// Err is the error fetching this wallet's net worth, if any.
+	Err error
+}
+
+// GetWalletNetWorth fetches a wallet's current net worth and the holdings
+// it is made of.
+//
+// Example:
+//
+//	nw, err := client.GetWalletNetWorth(ctx, walletAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("net worth $%s across %d tokens", nw.TotalValue, len(nw.Items))
+func (c *Client) GetWalletNetWorth(ctx context.Context, wallet string) (*WalletNetWorth, error) {
+	const path = "/wallet/v2/current-net-worth"
+
+	if wallet == "" {
+		return nil, &APIError{StatusCode: 400, Message: "wallet is required", Path: path}
+	}
+
+	params := url.Values{}
+	params.Set("wallet", wallet)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	nw, err := parseResponse[WalletNetWorth](body)
+	if err != nil {
+		return nil, err
+	}
+
+	if nw.Wallet == "" {
+		nw.Wallet = wallet
+	}
+
+	c.logger.Debug("fetched wallet net worth",
+		"wallet", wallet,
+		"total_value", nw.TotalValue.String(),
+		"holdings", len(nw.Items),
+	)
+
+	return nw, nil
+}
+
+// GetWalletNetWorths fetches the current net worth of many wallets
+// concurrently.
+//
+// Birdeye reports net worth one wallet per request, so requests are
+// fanned out with at most WithMaxConcurrency in flight. A failure for one
+// wallet does not stop the others; each wallet's outcome is reported in
+// its WalletNetWorthResult. The returned error is non-nil only for invalid
+// arguments.
+//
+// Returns a map of wallet address -> result. Duplicate wallets are
+// fetched once.
+func (c *Client) GetWalletNetWorths(ctx context.Context, wallets []string) (map[string]WalletNetWorthResult, error) {
+	const path = "/wallet/v2/current-net-worth"
+
+	for _, wallet := range wallets {
+		if wallet == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "wallet list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	values, errs := fetchEach(ctx, c, wallets, c.GetWalletNetWorth)
+
+	results := make(map[string]WalletNetWorthResult, len(values)+len(errs))
+	for wallet, nw := range values {
+		results[wallet] = WalletNetWorthResult{NetWorth: nw}
+	}
+	for wallet, err := range errs {
+		results[wallet] = WalletNetWorthResult{Err: err}
+	}
+
+	c.logger.Debug("fetched multiple wallet net worths",
+		"wallets", len(results),
+		"failed", len(errs),
+	)
+
+	return results, nil
+}
+
+// GetWalletNetWorthHistory fetches a wallet's net worth over time, oldest
+// point first.
+//
+// Example:
+//
+//	points, err := client.GetWalletNetWorthHistory(ctx, walletAddress, &birdeye.NetWorthHistoryOptions{
+//	    Interval: birdeye.NetWorthInterval1d,
+//	    Count:    30,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, p := range points {
+//	    log.Printf("%s: $%s (%s%%)", p.Time.Format(time.DateOnly), p.NetWorth, p.ChangePercent)
+//	}
+func (c *Client) GetWalletNetWorthHistory(ctx context.Context, wallet string, opts *NetWorthHistoryOptions) ([]NetWorthPoint, error) {
+	const path = "/wallet/v2/net-worth"
+
+	if wallet == "" {
+		return nil, &APIError{StatusCode: 400, Message: "wallet is required", Path: path}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(wallet))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := parseResponse[struct {
+		History []NetWorthPoint `json:"history"`
+	}](body)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(resp.History, func(i, j int) bool {
+		return resp.History[i].Time.Before(resp.History[j].Time)
+	})
+
+	c.logger.Debug("fetched wallet net worth history",
+		"wallet", wallet,
+		"points", len(resp.History),
+	)
+
+	return resp.History, nil
+}
@@ -0,0 +1,188 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetWalletNetWorth_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"wallet_address": "Wallet123",
+				"currency": "usd",
+				"total_value": "3456.78",
+				"current_timestamp": "2025-06-10T08:00:00Z",
+				"items": [
+					{
+						"address": "So11111111111111111111111111111111111111112",
+						"symbol": "SOL",
+						"decimals": 9,
+						"balance": "20000000000",
+						"amount": 20,
+						"price": 152.5,
+						"value": "3050"
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	nw, err := client.GetWalletNetWorth(context.Background(), "Wallet123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["wallet"][0] != "Wallet123" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if !nw.TotalValue.Equal(decimal.RequireFromString("3456.78")) {
+		t.Errorf("expected total 3456.78, got %s", nw.TotalValue)
+	}
+	if !nw.Timestamp.Equal(time.Date(2025, 6, 10, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected timestamp: %v", nw.Timestamp)
+	}
+	if len(nw.Items) != 1 || nw.Items[0].Symbol != "SOL" || !nw.Items[0].Value.Equal(decimal.NewFromInt(3050)) {
+		t.Errorf("unexpected items: %+v", nw.Items)
+	}
+}
+
+func TestGetWalletNetWorth_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	_, err := client.GetWalletNetWorth(context.Background(), "")
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetWalletNetWorths_PerWalletErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("wallet") == "bad" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"success": false, "message": "forbidden"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": true, "data": {"total_value": 10, "items": []}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	results, err := client.GetWalletNetWorths(context.Background(), []string{"w1", "bad", "w1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if r := results["w1"]; r.Err != nil || r.NetWorth.Wallet != "w1" || !r.NetWorth.TotalValue.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("unexpected result for w1: %+v", r)
+	}
+	if results["bad"].Err == nil || results["bad"].NetWorth != nil {
+		t.Errorf("expected error for bad wallet, got %+v", results["bad"])
+	}
+
+	if _, err := client.GetWalletNetWorths(context.Background(), []string{""}); err == nil {
+		t.Error("expected error for empty wallet")
+	}
+}
+
+func TestGetWalletNetWorthHistory_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"wallet_address": "Wallet123",
+				"history": [
+					{"timestamp": "2025-06-10T00:00:00Z", "net_worth": 1100, "net_worth_change": 100, "net_worth_change_percent": 10},
+					{"timestamp": "2025-06-09T00:00:00Z", "net_worth": 1000, "net_worth_change": -50, "net_worth_change_percent": -4.7619}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	points, err := client.GetWalletNetWorthHistory(context.Background(), "Wallet123", &NetWorthHistoryOptions{
+		Interval: NetWorthInterval1h,
+		Count:    24,
+		Before:   time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"wallet": "Wallet123", "type": "1h", "count": "24",
+		"direction": "back", "time": "2025-06-10T12:00:00Z",
+	}
+	for k, v := range expected {
+		if got := query[k]; len(got) != 1 || got[0] != v {
+			t.Errorf("expected %s=%s, got %v", k, v, got)
+		}
+	}
+
+	if len(points) != 2 {
+		t.Fatalf("expected 2 points, got %d", len(points))
+	}
+	// Sorted oldest first.
+	if !points[0].Time.Equal(time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected oldest point first, got %v", points[0].Time)
+	}
+	if !points[1].NetWorth.Equal(decimal.NewFromInt(1100)) || !points[1].ChangePercent.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("unexpected point: %+v", points[1])
+	}
+}
+
+func TestGetWalletNetWorthHistory_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name   string
+		wallet string
+		opts   *NetWorthHistoryOptions
+	}{
+		{"empty wallet", "", nil},
+		{"unsupported interval", "Wallet", &NetWorthHistoryOptions{Interval: "4h"}},
+		{"count too large", "Wallet", &NetWorthHistoryOptions{Count: 31}},
+		{"negative count", "Wallet", &NetWorthHistoryOptions{Count: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetWalletNetWorthHistory(context.Background(), tt.wallet, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetWalletNetWorthHistory_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/wallet/v2/net-worth": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetWalletNetWorthHistory(context.Background(), "Wallet", nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
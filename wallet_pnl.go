@@ -0,0 +1,271 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxPnLTokenFilter is the maximum number of token addresses a wallet PnL
+// request can be restricted to.
+const MaxPnLTokenFilter = 50
+
+// PnLCounts contains a wallet's trade counts.
+type PnLCounts struct {
+	// TotalBuy is the number of buys.
+	TotalBuy int `json:"total_buy"`
+
+	// TotalSell is the number of sells.
+	TotalSell int `json:"total_sell"`
+
+	// TotalTrade is the total number of trades.
+	TotalTrade int `json:"total_trade"`
+}
+
+// PnLCashflow contains a wallet's USD flows.
+type PnLCashflow struct {
+	// CostOfQuantitySold is the cost basis of the tokens sold.
+	CostOfQuantitySold decimal.Decimal `json:"cost_of_quantity_sold"`
+
+	// TotalInvested is the total USD spent buying.
+	TotalInvested decimal.Decimal `json:"total_invested"`
+
+	// TotalSold is the total USD received selling.
+	TotalSold decimal.Decimal `json:"total_sold"`
+
+	// CurrentValue is the current USD value of the remaining holding.
+	CurrentValue decimal.Decimal `json:"current_value"`
+}
+
+// PnL contains realized and unrealized profit and loss in USD.
+type PnL struct {
+	// RealizedProfitUSD is the profit locked in by sells.
+	RealizedProfitUSD decimal.Decimal `json:"realized_profit_usd"`
+
+	// RealizedProfitPercent is the realized profit relative to cost basis.
+	RealizedProfitPercent decimal.Decimal `json:"realized_profit_percent"`
+
+	// UnrealizedUSD is the profit on the remaining holding at current prices.
+	UnrealizedUSD decimal.Decimal `json:"unrealized_usd"`
+
+	// UnrealizedPercent is the unrealized profit relative to cost basis.
+	UnrealizedPercent decimal.Decimal `json:"unrealized_percent"`
+
+	// TotalUSD is realized plus unrealized profit.
+	TotalUSD decimal.Decimal `json:"total_usd"`
+
+	// TotalPercent is the total profit relative to the amount invested.
+	TotalPercent decimal.Decimal `json:"total_percent"`
+
+	// AvgProfitPerTradeUSD is the average profit per trade.
+	AvgProfitPerTradeUSD decimal.Decimal `json:"avg_profit_per_trade_usd"`
+}
+
+// TokenPnLQuantity contains a wallet's token quantities, adjusted for
+// decimals.
+type TokenPnLQuantity struct {
+	// TotalBoughtAmount is the total amount bought.
+	TotalBoughtAmount decimal.Decimal `json:"total_bought_amount"`
+
+	// TotalSoldAmount is the total amount sold.
+	TotalSoldAmount decimal.Decimal `json:"total_sold_amount"`
+
+	// Holding is the amount currently held.
+	Holding decimal.Decimal `json:"holding"`
+}
+
+// TokenPnLPricing contains a token's current price and a wallet's average
+// costs in USD.
+type TokenPnLPricing struct {
+	// CurrentPrice is the token's current price.
+	CurrentPrice decimal.Decimal `json:"current_price"`
+
+	// AvgBuyCost is the average price paid per token.
+	AvgBuyCost decimal.Decimal `json:"avg_buy_cost"`
+
+	// AvgSellCost is the average price received per token.
+	AvgSellCost decimal.Decimal `json:"avg_sell_cost"`
+}
+
+// TokenPnL is a wallet's profit and loss for a single token.
+type TokenPnL struct {
+	// Address is the token's mint address.
+	Address string `json:"-"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Counts are the wallet's trade counts for the token.
+	Counts PnLCounts `json:"counts"`
+
+	// Quantity are the amounts bought, sold and held.
+	Quantity TokenPnLQuantity `json:"quantity"`
+
+	// Cashflow are the USD flows for the token.
+	Cashflow PnLCashflow `json:"cashflow_usd"`
+
+	// PnL is the profit and loss for the token.
+	PnL PnL `json:"pnl"`
+
+	// Pricing is the current price and average costs.
+	Pricing TokenPnLPricing `json:"pricing"`
+}
+
+// WalletPnLSummary is a wallet's profit and loss across all tokens.
+type WalletPnLSummary struct {
+	// UniqueTokens is the number of distinct tokens traded.
+	UniqueTokens int `json:"unique_tokens"`
+
+	// Counts are the wallet's trade counts.
+	Counts PnLCounts `json:"counts"`
+
+	// Cashflow are the wallet's USD flows.
+	Cashflow PnLCashflow `json:"cashflow_usd"`
+
+	// PnL is the wallet's profit and loss.
+	PnL PnL `json:"pnl"`
+}
+
+// WalletPnL contains a wallet's profit and loss, overall and per token.
+type WalletPnL struct {
+	// Wallet is the wallet address.
+	Wallet string `json:"-"`
+
+	// Summary is the profit and loss across all tokens.
+	Summary WalletPnLSummary `json:"summary"`
+
+	// Tokens is the per-token breakdown, keyed by mint address.
+	Tokens map[string]TokenPnL `json:"tokens"`
+}
+
+// WalletPnLOptions configures wallet PnL requests.
+//
+// A nil *WalletPnLOptions reports every token the wallet has traded.
+type WalletPnLOptions struct {
+	// TokenAddresses restricts the breakdown to these tokens (at most 50).
+	// Empty reports all tokens.
+	TokenAddresses []string
+}
+
+// WalletPnLResult is the outcome of fetching one wallet's PnL in
+// GetWalletPnLs.
+type WalletPnLResult struct {
+	// PnL is the wallet's profit and loss. It is nil if Err is set.
+	PnL *WalletPnL
+
+	// Err is the error fetching this wallet's PnL, if any.
+	Err error
+}
+
+// GetWalletPnL fetches a wallet's realized and unrealized profit and loss.
+//
+// Example:
+//
+//	pnl, err := client.GetWalletPnL(ctx, walletAddress, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("realized $%s, unrealized $%s",
+//	    pnl.Summary.PnL.RealizedProfitUSD, pnl.Summary.PnL.UnrealizedUSD)
+//	for mint, token := range pnl.Tokens {
+//	    log.Printf("%s (%s): $%s", token.Symbol, mint, token.PnL.TotalUSD)
+//	}
+func (c *Client) GetWalletPnL(ctx context.Context, wallet string, opts *WalletPnLOptions) (*WalletPnL, error) {
+	const path = "/wallet/v2/pnl"
+
+	if wallet == "" {
+		return nil, &APIError{StatusCode: 400, Message: "wallet is required", Path: path}
+	}
+
+	params := url.Values{}
+	params.Set("wallet", wallet)
+
+	if opts != nil && len(opts.TokenAddresses) > 0 {
+		if len(opts.TokenAddresses) > MaxPnLTokenFilter {
+			return nil, &APIError{StatusCode: 400, Message: "at most 50 token addresses are supported", Path: path}
+		}
+		for _, addr := range opts.TokenAddresses {
+			if addr == "" {
+				return nil, &APIError{
+					StatusCode: 400,
+					Message:    "token address list contains empty string",
+					Path:       path,
+				}
+			}
+		}
+		params.Set("token_addresses", strings.Join(opts.TokenAddresses, ","))
+	}
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	pnl, err := parseResponse[WalletPnL](body)
+	if err != nil {
+		return nil, err
+	}
+
+	pnl.Wallet = wallet
+	for mint, token := range pnl.Tokens {
+		token.Address = mint
+		pnl.Tokens[mint] = token
+	}
+
+	c.logger.Debug("fetched wallet pnl",
+		"wallet", wallet,
+		"tokens", len(pnl.Tokens),
+		"total_usd", pnl.Summary.PnL.TotalUSD.String(),
+	)
+
+	return pnl, nil
+}
+
+// GetWalletPnLs fetches profit and loss for many wallets concurrently.
+//
+// Birdeye reports PnL one wallet per request, so requests are fanned out
+// with at most WithMaxConcurrency in flight. A failure for one wallet does
+// not stop the others; each wallet's outcome is reported in its
+// WalletPnLResult. The returned error is non-nil only for invalid
+// arguments.
+//
+// Returns a map of wallet address -> result. Duplicate wallets are
+// fetched once.
+func (c *Client) GetWalletPnLs(ctx context.Context, wallets []string, opts *WalletPnLOptions) (map[string]WalletPnLResult, error) {
+	const path = "/wallet/v2/pnl"
+
+	for _, wallet := range wallets {
+		if wallet == "" {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    "wallet list contains empty string",
+				Path:       path,
+			}
+		}
+	}
+
+	pnls, errs := fetchEach(ctx, c, wallets,
+		func(ctx context.Context, wallet string) (*WalletPnL, error) {
+			return c.GetWalletPnL(ctx, wallet, opts)
+		})
+
+	results := make(map[string]WalletPnLResult, len(pnls)+len(errs))
+	for wallet, pnl := range pnls {
+		results[wallet] = WalletPnLResult{PnL: pnl}
+	}
+	for wallet, err := range errs {
+		results[wallet] = WalletPnLResult{Err: err}
+	}
+
+	c.logger.Debug("fetched multiple wallet pnls",
+		"wallets", len(results),
+		"failed", len(errs),
+	)
+
+	return results, nil
+}
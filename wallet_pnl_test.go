@@ -0,0 +1,172 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetWalletPnL_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"meta": {"address": "Wallet123", "currency": "usd"},
+				"tokens": {
+					"So11111111111111111111111111111111111111112": {
+						"symbol": "SOL",
+						"decimals": 9,
+						"counts": {"total_buy": 3, "total_sell": 1, "total_trade": 4},
+						"quantity": {"total_bought_amount": 30, "total_sold_amount": 10, "holding": 20},
+						"cashflow_usd": {"cost_of_quantity_sold": 1400, "total_invested": 4200, "total_sold": 1600, "current_value": 3040},
+						"pnl": {
+							"realized_profit_usd": 200,
+							"realized_profit_percent": 14.285714,
+							"unrealized_usd": 240,
+							"unrealized_percent": 8.571428,
+							"total_usd": 440,
+							"total_percent": 10.476190,
+							"avg_profit_per_trade_usd": 110
+						},
+						"pricing": {"current_price": 152, "avg_buy_cost": 140, "avg_sell_cost": 160}
+					}
+				},
+				"summary": {
+					"unique_tokens": 1,
+					"counts": {"total_buy": 3, "total_sell": 1, "total_trade": 4},
+					"cashflow_usd": {"total_invested": 4200, "total_sold": 1600},
+					"pnl": {"realized_profit_usd": 200, "unrealized_usd": 240, "total_usd": 440}
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	pnl, err := client.GetWalletPnL(context.Background(), "Wallet123", &WalletPnLOptions{
+		TokenAddresses: []string{"So11111111111111111111111111111111111111112", "Other"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["wallet"][0] != "Wallet123" || query["token_addresses"][0] != "So11111111111111111111111111111111111111112,Other" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if pnl.Wallet != "Wallet123" {
+		t.Errorf("expected wallet Wallet123, got %s", pnl.Wallet)
+	}
+	if pnl.Summary.UniqueTokens != 1 || pnl.Summary.Counts.TotalTrade != 4 {
+		t.Errorf("unexpected summary: %+v", pnl.Summary)
+	}
+	if !pnl.Summary.PnL.TotalUSD.Equal(decimal.NewFromInt(440)) {
+		t.Errorf("expected total pnl 440, got %s", pnl.Summary.PnL.TotalUSD)
+	}
+
+	sol, ok := pnl.Tokens["So11111111111111111111111111111111111111112"]
+	if !ok {
+		t.Fatal("expected SOL breakdown")
+	}
+	if sol.Address != "So11111111111111111111111111111111111111112" || sol.Symbol != "SOL" {
+		t.Errorf("unexpected token: %+v", sol)
+	}
+	if !sol.Quantity.Holding.Equal(decimal.NewFromInt(20)) || !sol.Pricing.AvgBuyCost.Equal(decimal.NewFromInt(140)) {
+		t.Errorf("unexpected quantity/pricing: %+v / %+v", sol.Quantity, sol.Pricing)
+	}
+	if !sol.PnL.RealizedProfitPercent.Equal(decimal.RequireFromString("14.285714")) {
+		t.Errorf("expected realized 14.285714%%, got %s", sol.PnL.RealizedProfitPercent)
+	}
+	if !sol.Cashflow.CurrentValue.Equal(decimal.NewFromInt(3040)) {
+		t.Errorf("expected current value 3040, got %s", sol.Cashflow.CurrentValue)
+	}
+}
+
+func TestGetWalletPnL_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tooMany := make([]string, MaxPnLTokenFilter+1)
+	for i := range tooMany {
+		tooMany[i] = "token" + strconv.Itoa(i)
+	}
+
+	tests := []struct {
+		name   string
+		wallet string
+		opts   *WalletPnLOptions
+	}{
+		{"empty wallet", "", nil},
+		{"too many tokens", "Wallet", &WalletPnLOptions{TokenAddresses: tooMany}},
+		{"empty token", "Wallet", &WalletPnLOptions{TokenAddresses: []string{"a", ""}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetWalletPnL(context.Background(), tt.wallet, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetWalletPnL_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/wallet/v2/pnl": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetWalletPnL(context.Background(), "Wallet", nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestGetWalletPnLs_PerWalletErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wallet := r.URL.Query().Get("wallet")
+		if strings.HasPrefix(wallet, "bad") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"success": false, "message": "forbidden"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": true, "data": {"summary": {"pnl": {"total_usd": 100}}, "tokens": {}}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	results, err := client.GetWalletPnLs(context.Background(), []string{"w1", "bad1", "w2"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for _, wallet := range []string{"w1", "w2"} {
+		r := results[wallet]
+		if r.Err != nil || r.PnL.Wallet != wallet || !r.PnL.Summary.PnL.TotalUSD.Equal(decimal.NewFromInt(100)) {
+			t.Errorf("%s: unexpected result: %+v", wallet, r)
+		}
+	}
+	if apiErr, ok := IsAPIError(results["bad1"].Err); !ok || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected 403 APIError for bad1, got %v", results["bad1"].Err)
+	}
+}
+
+func TestGetWalletPnLs_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	if _, err := client.GetWalletPnLs(context.Background(), []string{"a", ""}, nil); err == nil {
+		t.Error("expected error for empty wallet")
+	}
+}
@@ -0,0 +1,111 @@
+package birdeye
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/shopspring/decimal"
+)
+
+// WalletHolding is a single token held by a wallet.
+type WalletHolding struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// LogoURI is a URL to the token's logo image.
+	LogoURI string `json:"logoURI"`
+
+	// Balance is the raw token balance (not adjusted for decimals).
+	Balance decimal.Decimal `json:"balance"`
+
+	// UIAmount is the balance adjusted for decimals.
+	UIAmount decimal.Decimal `json:"-"`
+
+	// PriceUSD is the token's current price in USD.
+	PriceUSD decimal.Decimal `json:"priceUsd"`
+
+	// ValueUSD is the holding's value in USD.
+	ValueUSD decimal.Decimal `json:"valueUsd"`
+}
+
+// WalletPortfolio contains a wallet's token holdings valued in USD.
+type WalletPortfolio struct {
+	// Wallet is the wallet address.
+	Wallet string `json:"wallet"`
+
+	// TotalUSD is the total value of all holdings in USD.
+	TotalUSD decimal.Decimal `json:"totalUsd"`
+
+	// Items are the wallet's holdings.
+	Items []WalletHolding `json:"items"`
+}
+
+// GetWalletPortfolio fetches a wallet's token holdings and their USD value.
+//
+// Example:
+//
+//	portfolio, err := client.GetWalletPortfolio(ctx, walletAddress)
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("total: $%s", portfolio.TotalUSD)
+//	for _, h := range birdeye.FilterDust(portfolio.Items, decimal.NewFromInt(1)) {
+//	    log.Printf("%s: %s ($%s)", h.Symbol, h.UIAmount, h.ValueUSD)
+//	}
+func (c *Client) GetWalletPortfolio(ctx context.Context, wallet string) (*WalletPortfolio, error) {
+	const path = "/v1/wallet/token_list"
+
+	if wallet == "" {
+		return nil, &APIError{StatusCode: 400, Message: "wallet is required", Path: path}
+	}
+
+	params := url.Values{}
+	params.Set("wallet", wallet)
+
+	body, err := c.doGet(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio, err := parseResponse[WalletPortfolio](body)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range portfolio.Items {
+		h := &portfolio.Items[i]
+		h.UIAmount = uiAmount(h.Balance, h.Decimals)
+	}
+
+	c.logger.Debug("fetched wallet portfolio",
+		"wallet", wallet,
+		"holdings", len(portfolio.Items),
+		"total_usd", portfolio.TotalUSD.String(),
+	)
+
+	return portfolio, nil
+}
+
+// FilterDust returns the holdings worth at least minValue USD, preserving
+// their order.
+//
+// Holdings Birdeye cannot price have a zero value and are dropped by any
+// positive threshold.
+func FilterDust(holdings []WalletHolding, minValue decimal.Decimal) []WalletHolding {
+	kept := make([]WalletHolding, 0, len(holdings))
+	for _, h := range holdings {
+		if h.ValueUSD.GreaterThanOrEqual(minValue) {
+			kept = append(kept, h)
+		}
+	}
+	return kept
+}
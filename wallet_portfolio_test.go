@@ -0,0 +1,131 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetWalletPortfolio_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"wallet": "Wallet123",
+				"totalUsd": 1523.45,
+				"items": [
+					{
+						"address": "So11111111111111111111111111111111111111112",
+						"symbol": "SOL",
+						"name": "Wrapped SOL",
+						"decimals": 9,
+						"balance": 10000000001,
+						"uiAmount": 10.000000001,
+						"priceUsd": 152.3,
+						"valueUsd": 1523.0000001523
+					},
+					{
+						"address": "DustMint",
+						"symbol": "DUST",
+						"decimals": 6,
+						"balance": "123",
+						"priceUsd": null,
+						"valueUsd": null
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	portfolio, err := client.GetWalletPortfolio(context.Background(), "Wallet123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["wallet"][0] != "Wallet123" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if !portfolio.TotalUSD.Equal(decimal.RequireFromString("1523.45")) {
+		t.Errorf("expected total 1523.45, got %s", portfolio.TotalUSD)
+	}
+	if len(portfolio.Items) != 2 {
+		t.Fatalf("expected 2 holdings, got %d", len(portfolio.Items))
+	}
+
+	sol := portfolio.Items[0]
+	if sol.Symbol != "SOL" || sol.Balance.String() != "10000000001" {
+		t.Errorf("unexpected holding: %+v", sol)
+	}
+	if sol.UIAmount.String() != "10.000000001" {
+		t.Errorf("expected ui amount 10.000000001, got %s", sol.UIAmount)
+	}
+
+	dust := portfolio.Items[1]
+	if dust.UIAmount.String() != "0.000123" || !dust.ValueUSD.IsZero() {
+		t.Errorf("unexpected dust holding: %+v", dust)
+	}
+}
+
+func TestGetWalletPortfolio_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	_, err := client.GetWalletPortfolio(context.Background(), "")
+	apiErr, ok := IsAPIError(err)
+	if !ok || apiErr.StatusCode != 400 {
+		t.Errorf("expected 400 APIError, got %v", err)
+	}
+}
+
+func TestGetWalletPortfolio_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/v1/wallet/token_list": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.GetWalletPortfolio(context.Background(), "Wallet123"); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestFilterDust(t *testing.T) {
+	holdings := []WalletHolding{
+		{Symbol: "SOL", ValueUSD: decimal.NewFromInt(1500)},
+		{Symbol: "DUST", ValueUSD: decimal.RequireFromString("0.42")},
+		{Symbol: "EDGE", ValueUSD: decimal.NewFromInt(1)},
+		{Symbol: "UNPRICED"},
+	}
+
+	tests := []struct {
+		name     string
+		min      decimal.Decimal
+		expected []string
+	}{
+		{"one dollar", decimal.NewFromInt(1), []string{"SOL", "EDGE"}},
+		{"zero keeps all", decimal.Zero, []string{"SOL", "DUST", "EDGE", "UNPRICED"}},
+		{"above all", decimal.NewFromInt(10000), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FilterDust(holdings, tt.min)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %+v", tt.expected, got)
+			}
+			for i, sym := range tt.expected {
+				if got[i].Symbol != sym {
+					t.Errorf("expected %s at %d, got %s", sym, i, got[i].Symbol)
+				}
+			}
+		})
+	}
+}
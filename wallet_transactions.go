@@ -0,0 +1,291 @@
+package birdeye
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxWalletTxLimit is the maximum number of wallet transactions Birdeye
+// returns per page.
+const MaxWalletTxLimit = 100
+
+// WalletBalanceChange is the change in one token's balance caused by a
+// wallet transaction.
+type WalletBalanceChange struct {
+	// Address is the token's mint address.
+	Address string `json:"address"`
+
+	// Symbol is the token's trading symbol.
+	Symbol string `json:"symbol"`
+
+	// Name is the token's full name.
+	Name string `json:"name"`
+
+	// Decimals is the number of decimal places for the token.
+	Decimals int `json:"decimals"`
+
+	// Amount is the raw balance change (not adjusted for decimals).
+	// Negative values are outflows.
+	Amount decimal.Decimal `json:"amount"`
+
+	// UIAmount is the balance change adjusted for decimals.
+	UIAmount decimal.Decimal `json:"-"`
+}
+
+// WalletTransaction is a single transaction involving a wallet.
+type WalletTransaction struct {
+	// TxHash is the transaction signature.
+	TxHash string `json:"txHash"`
+
+	// Slot is the slot the transaction landed in.
+	Slot uint64 `json:"blockNumber"`
+
+	// BlockHumanTime is the block time as returned by Birdeye.
+	BlockHumanTime string `json:"blockTime"`
+
+	// BlockTime is BlockHumanTime parsed as UTC.
+	BlockTime time.Time `json:"-"`
+
+	// Status reports whether the transaction succeeded.
+	Status bool `json:"status"`
+
+	// From is the fee payer.
+	From string `json:"from"`
+
+	// To is the main program or account the transaction interacted with.
+	To string `json:"to"`
+
+	// Fee is the transaction fee in lamports.
+	Fee uint64 `json:"fee"`
+
+	// MainAction is Birdeye's classification of the transaction
+	// (e.g., "send", "received", "swap").
+	MainAction string `json:"mainAction"`
+
+	// BalanceChanges are the wallet's per-token balance changes.
+	BalanceChanges []WalletBalanceChange `json:"balanceChange"`
+}
+
+// WalletTxOptions configures wallet transaction requests.
+//
+// A nil *WalletTxOptions returns the most recent transactions.
+type WalletTxOptions struct {
+	// Before is a transaction signature cursor. Only transactions older
+	// than it are returned. Empty starts from the most recent.
+	Before string
+
+	// Limit is the page size (1-100). Zero uses the maximum.
+	Limit int
+
+	// AfterTime limits results to transactions at or after this time.
+	// Zero means no lower bound.
+	AfterTime time.Time
+
+	// BeforeTime limits results to transactions strictly before this time.
+	// Zero means no upper bound.
+	BeforeTime time.Time
+
+	// MaxItems caps the number of transactions yielded by
+	// AllWalletTransactions. Zero means no cap. It is ignored by
+	// ListWalletTransactions.
+	MaxItems int
+}
+
+// validate checks the options for values Birdeye would reject.
+func (o *WalletTxOptions) validate(path string) error {
+	if o == nil {
+		return nil
+	}
+	if o.Limit < 0 || o.Limit > MaxWalletTxLimit {
+		return &APIError{StatusCode: 400, Message: "limit must be between 1 and 100", Path: path}
+	}
+	if !o.BeforeTime.IsZero() && !o.AfterTime.IsZero() && !o.AfterTime.Before(o.BeforeTime) {
+		return &APIError{StatusCode: 400, Message: "after time must be before before time", Path: path}
+	}
+	return nil
+}
+
+// params builds the query parameters for a wallet transaction request.
+func (o *WalletTxOptions) params(wallet string) url.Values {
+	params := url.Values{}
+	params.Set("wallet", wallet)
+
+	limit := MaxWalletTxLimit
+	if o != nil && o.Limit > 0 {
+		limit = o.Limit
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	if o != nil && o.Before != "" {
+		params.Set("before", o.Before)
+	}
+
+	return params
+}
+
+// pageLimit implements pagedOptions.
+func (o *WalletTxOptions) pageLimit() *int {
+	return &o.Limit
+}
+
+// inRange reports whether tx falls within the options' time range.
+func (o *WalletTxOptions) inRange(tx *WalletTransaction) bool {
+	if o == nil {
+		return true
+	}
+	if !o.AfterTime.IsZero() && tx.BlockTime.Before(o.AfterTime) {
+		return false
+	}
+	if !o.BeforeTime.IsZero() && !tx.BlockTime.Before(o.BeforeTime) {
+		return false
+	}
+	return true
+}
+
+// ListWalletTransactions fetches a page of a wallet's transactions, most
+// recent first.
+//
+// Birdeye has no server-side time filter, so AfterTime and BeforeTime are
+// applied to the fetched page; a filtered page may hold fewer transactions
+// than the limit. Use AllWalletTransactions to apply a time range across
+// pages.
+//
+// Example:
+//
+//	txs, err := client.ListWalletTransactions(ctx, walletAddress, &birdeye.WalletTxOptions{Limit: 20})
+//	if err != nil {
+//	    return err
+//	}
+//	for _, tx := range txs {
+//	    log.Printf("%s %s at %s", tx.TxHash, tx.MainAction, tx.BlockTime)
+//	}
+func (c *Client) ListWalletTransactions(ctx context.Context, wallet string, opts *WalletTxOptions) ([]WalletTransaction, error) {
+	txs, err := c.fetchWalletTransactions(ctx, wallet, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := txs[:0]
+	for i := range txs {
+		if opts.inRange(&txs[i]) {
+			filtered = append(filtered, txs[i])
+		}
+	}
+
+	return filtered, nil
+}
+
+// AllWalletTransactions returns a lazy iterator over a wallet's
+// transactions, most recent first, following the before cursor as the
+// caller consumes them.
+//
+// Transactions newer than opts.BeforeTime are skipped, and iteration stops
+// at the first transaction older than opts.AfterTime. Set opts.MaxItems to
+// bound the total number of transactions.
+//
+// Example:
+//
+//	opts := &birdeye.WalletTxOptions{AfterTime: time.Now().Add(-24 * time.Hour)}
+//	for tx, err := range client.AllWalletTransactions(ctx, walletAddress, opts) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fees += tx.Fee
+//	}
+func (c *Client) AllWalletTransactions(ctx context.Context, wallet string, opts *WalletTxOptions) iter.Seq2[WalletTransaction, error] {
+	page := iteratorOptions(opts, MaxWalletTxLimit)
+
+	return func(yield func(WalletTransaction, error) bool) {
+		yielded := 0
+		for {
+			txs, err := c.fetchWalletTransactions(ctx, wallet, &page)
+			if err != nil {
+				yield(WalletTransaction{}, err)
+				return
+			}
+
+			for i := range txs {
+				tx := &txs[i]
+				if !page.AfterTime.IsZero() && tx.BlockTime.Before(page.AfterTime) {
+					return
+				}
+				if !page.inRange(tx) {
+					continue
+				}
+				if page.MaxItems > 0 && yielded >= page.MaxItems {
+					return
+				}
+				if !yield(*tx, nil) {
+					return
+				}
+				yielded++
+			}
+
+			if len(txs) < page.Limit {
+				return
+			}
+			if page.MaxItems > 0 && yielded >= page.MaxItems {
+				return
+			}
+
+			next := txs[len(txs)-1].TxHash
+			if next == "" || next == page.Before {
+				return
+			}
+			page.Before = next
+		}
+	}
+}
+
+// fetchWalletTransactions fetches one unfiltered page of wallet
+// transactions.
+func (c *Client) fetchWalletTransactions(ctx context.Context, wallet string, opts *WalletTxOptions) ([]WalletTransaction, error) {
+	const path = "/v1/wallet/tx_list"
+
+	if wallet == "" {
+		return nil, &APIError{StatusCode: 400, Message: "wallet is required", Path: path}
+	}
+	if err := opts.validate(path); err != nil {
+		return nil, err
+	}
+
+	body, err := c.doGet(ctx, path, opts.params(wallet))
+	if err != nil {
+		return nil, err
+	}
+
+	// Transactions are keyed by chain name.
+	resp, err := parseResponse[map[string][]WalletTransaction](body)
+	if err != nil {
+		return nil, err
+	}
+	txs := (*resp)[chainSolana]
+
+	for i := range txs {
+		tx := &txs[i]
+		if tx.BlockHumanTime != "" {
+			blockTime, err := time.Parse(time.RFC3339, tx.BlockHumanTime)
+			if err != nil {
+				return nil, fmt.Errorf("parse block time for %s: %w", tx.TxHash, err)
+			}
+			tx.BlockTime = blockTime.UTC()
+		}
+		for j := range tx.BalanceChanges {
+			change := &tx.BalanceChanges[j]
+			change.UIAmount = uiAmount(change.Amount, change.Decimals)
+		}
+	}
+
+	c.logger.Debug("fetched wallet transactions",
+		"wallet", wallet,
+		"count", len(txs),
+	)
+
+	return txs, nil
+}
@@ -0,0 +1,241 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// walletTxServer serves n transactions, one per minute going back from
+// newest, honoring the before cursor and limit.
+func walletTxServer(t *testing.T, newest time.Time, n int, requests *int) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		q := r.URL.Query()
+		limit, _ := strconv.Atoi(q.Get("limit"))
+
+		start := 0
+		if before := q.Get("before"); before != "" {
+			start, _ = strconv.Atoi(before[len("tx"):])
+			start++
+		}
+
+		txs := []map[string]interface{}{}
+		for i := start; i < n && len(txs) < limit; i++ {
+			txs = append(txs, map[string]interface{}{
+				"txHash":    "tx" + strconv.Itoa(i),
+				"blockTime": newest.Add(-time.Duration(i) * time.Minute).Format(time.RFC3339),
+				"status":    true,
+			})
+		}
+		_ = json.NewEncoder(w).Encode(wrapResponse(map[string]interface{}{"solana": txs}))
+	}))
+}
+
+func TestListWalletTransactions_Success(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{
+			"success": true,
+			"data": {
+				"solana": [
+					{
+						"txHash": "SwapTx",
+						"blockNumber": 290000000,
+						"blockTime": "2024-09-18T09:31:31+00:00",
+						"status": true,
+						"from": "Wallet123",
+						"to": "JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4",
+						"fee": 5000,
+						"mainAction": "swap",
+						"balanceChange": [
+							{"amount": -1500000000, "symbol": "SOL", "decimals": 9, "address": "So11111111111111111111111111111111111111112"},
+							{"amount": 228450000, "symbol": "USDC", "decimals": 6, "address": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"}
+						]
+					}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	txs, err := client.ListWalletTransactions(context.Background(), "Wallet123", &WalletTxOptions{Before: "Cursor", Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["wallet"][0] != "Wallet123" || query["before"][0] != "Cursor" || query["limit"][0] != "10" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	if len(txs) != 1 {
+		t.Fatalf("expected 1 transaction, got %d", len(txs))
+	}
+
+	tx := txs[0]
+	if tx.TxHash != "SwapTx" || tx.Slot != 290000000 || !tx.Status || tx.Fee != 5000 || tx.MainAction != "swap" {
+		t.Errorf("unexpected transaction: %+v", tx)
+	}
+	if !tx.BlockTime.Equal(time.Date(2024, 9, 18, 9, 31, 31, 0, time.UTC)) {
+		t.Errorf("unexpected block time: %v", tx.BlockTime)
+	}
+	if len(tx.BalanceChanges) != 2 {
+		t.Fatalf("expected 2 balance changes, got %d", len(tx.BalanceChanges))
+	}
+	if tx.BalanceChanges[0].UIAmount.String() != "-1.5" || tx.BalanceChanges[1].UIAmount.String() != "228.45" {
+		t.Errorf("unexpected balance changes: %+v", tx.BalanceChanges)
+	}
+}
+
+func TestListWalletTransactions_FiltersPage(t *testing.T) {
+	newest := time.Date(2024, 9, 18, 12, 0, 0, 0, time.UTC)
+	var requests int
+	server := walletTxServer(t, newest, 10, &requests)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	txs, err := client.ListWalletTransactions(context.Background(), "Wallet", &WalletTxOptions{
+		BeforeTime: newest.Add(-2 * time.Minute),
+		AfterTime:  newest.Add(-5 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var hashes []string
+	for _, tx := range txs {
+		hashes = append(hashes, tx.TxHash)
+	}
+	// BeforeTime is exclusive and AfterTime inclusive.
+	if len(hashes) != 3 || hashes[0] != "tx3" || hashes[2] != "tx5" {
+		t.Errorf("expected tx3..tx5, got %v", hashes)
+	}
+}
+
+func TestListWalletTransactions_Validation(t *testing.T) {
+	client, _ := NewClient("test-key")
+
+	tests := []struct {
+		name   string
+		wallet string
+		opts   *WalletTxOptions
+	}{
+		{"empty wallet", "", nil},
+		{"limit too large", "Wallet", &WalletTxOptions{Limit: 101}},
+		{"inverted window", "Wallet", &WalletTxOptions{AfterTime: time.Unix(200, 0), BeforeTime: time.Unix(100, 0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListWalletTransactions(context.Background(), tt.wallet, tt.opts)
+			apiErr, ok := IsAPIError(err)
+			if !ok || apiErr.StatusCode != 400 {
+				t.Errorf("expected 400 APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestListWalletTransactions_SuccessFalse(t *testing.T) {
+	responses := map[string]interface{}{
+		"/v1/wallet/tx_list": wrapFailure(),
+	}
+
+	server := testServer(t, responses)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+	if _, err := client.ListWalletTransactions(context.Background(), "Wallet", nil); err == nil {
+		t.Error("expected error for success=false response")
+	}
+}
+
+func TestAllWalletTransactions_FollowsCursor(t *testing.T) {
+	newest := time.Date(2024, 9, 18, 12, 0, 0, 0, time.UTC)
+	var requests int
+	server := walletTxServer(t, newest, 25, &requests)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	count := 0
+	for tx, err := range client.AllWalletTransactions(context.Background(), "Wallet", &WalletTxOptions{Limit: 10}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tx.TxHash != "tx"+strconv.Itoa(count) {
+			t.Errorf("expected tx%d, got %s", count, tx.TxHash)
+		}
+		count++
+	}
+
+	if count != 25 {
+		t.Errorf("expected 25 transactions, got %d", count)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestAllWalletTransactions_TimeRange(t *testing.T) {
+	newest := time.Date(2024, 9, 18, 12, 0, 0, 0, time.UTC)
+	var requests int
+	server := walletTxServer(t, newest, 1000, &requests)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	// tx12 through tx27, spanning two page boundaries.
+	opts := &WalletTxOptions{
+		Limit:      10,
+		BeforeTime: newest.Add(-11*time.Minute - 30*time.Second),
+		AfterTime:  newest.Add(-27 * time.Minute),
+	}
+
+	var hashes []string
+	for tx, err := range client.AllWalletTransactions(context.Background(), "Wallet", opts) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		hashes = append(hashes, tx.TxHash)
+	}
+
+	if len(hashes) != 16 || hashes[0] != "tx12" || hashes[15] != "tx27" {
+		t.Errorf("expected tx12..tx27, got %v", hashes)
+	}
+	// Stops at the first transaction older than AfterTime.
+	if requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestAllWalletTransactions_MaxItems(t *testing.T) {
+	newest := time.Date(2024, 9, 18, 12, 0, 0, 0, time.UTC)
+	var requests int
+	server := walletTxServer(t, newest, 1000, &requests)
+	defer server.Close()
+
+	client := testClient(t, server.URL)
+
+	count := 0
+	for _, err := range client.AllWalletTransactions(context.Background(), "Wallet", &WalletTxOptions{Limit: 10, MaxItems: 15}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		count++
+	}
+
+	if count != 15 {
+		t.Errorf("expected 15 transactions, got %d", count)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 page requests, got %d", requests)
+	}
+}